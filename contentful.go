@@ -13,10 +13,12 @@ import (
 
 	"github.com/labd/contentful-go/internal/cda"
 	"github.com/labd/contentful-go/internal/cma"
+	"github.com/labd/contentful-go/internal/graphql"
 	"github.com/labd/contentful-go/pkgs/client"
 	"github.com/labd/contentful-go/pkgs/common"
 	cda_service "github.com/labd/contentful-go/service/cda"
 	cma_service "github.com/labd/contentful-go/service/cma"
+	graphql_service "github.com/labd/contentful-go/service/graphql"
 
 	"moul.io/http2curl"
 )
@@ -64,6 +66,10 @@ func NewCDAV2(config client.ClientConfig) (cda_service.SpaceIdClientBuilder, err
 	return cda.New(config)
 }
 
+func NewGraphQL(config client.ClientConfig) (graphql_service.SpaceIdClientBuilder, error) {
+	return graphql.New(config)
+}
+
 // NewCMA returns a CMA client
 func NewCMA(token string) *Client {
 	c := &Client{
@@ -0,0 +1,294 @@
+package access_tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/access"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// organizationHandler serves the organization and space id1 from the fixtures and
+// records the requests which change them, with their bodies. Lists are served in
+// pages of pageSize items, or in a single page when it is 0.
+func organizationHandler(changes *[]string, pageSize int) testutil.HTTPHandler {
+	lists := map[string]string{
+		"/organizations/org1/users":                    "user/list.json",
+		"/organizations/org1/organization_memberships": "organization_membership/list.json",
+		"/organizations/org1/teams":                    "team/list.json",
+		"/organizations/org1/team_memberships":         "team_membership/list.json",
+		"/spaces/id1/roles":                            "role/list.json",
+		"/spaces/id1/space_memberships":                "space_membership/list.json",
+		"/spaces/id1/team_space_memberships":           "team_space_membership/list.json",
+	}
+
+	created := map[string]string{
+		"invitations":              "invitation/get.json",
+		"team_memberships":         "team_membership/get.json",
+		"space_memberships":        "space_membership/get.json",
+		"team_space_memberships":   "team_space_membership/get.json",
+		"organization_memberships": "organization_membership/get.json",
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && pageSize > 0 {
+			testutil.PagedHandler(lists[r.URL.Path], pageSize)(w, r)
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintln(w, testutil.ReadTestData(lists[r.URL.Path]))
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		*changes = append(*changes, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(body)))
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		segments := strings.Split(r.URL.Path, "/")
+		collection := segments[len(segments)-1]
+		if r.Method == http.MethodPut {
+			collection = segments[len(segments)-2]
+		}
+
+		if collection == "teams" {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, `{"sys": {"type": "Team", "id": "3kQwXJmZ5hY7ZQ0dDkL1vM", "version": 1}, "name": "Editors"}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintln(w, testutil.ReadTestData(created[collection]))
+	}
+}
+
+func loadConfig(t *testing.T) *access.Config {
+	config, err := access.Parse([]byte(testutil.ReadTestData("access/config.yaml")))
+	assert.Nil(t, err)
+
+	return config
+}
+
+func TestReconciler_Plan(t *testing.T) {
+	assertions := assert.New(t)
+
+	var changes []string
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, organizationHandler(&changes, 0), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	reconciler := access.New(cma, testutil.OrganizationId, &access.Options{DryRun: true})
+
+	plan, err := reconciler.Reconcile(context.Background(), loadConfig(t))
+	assertions.Nil(err)
+	assertions.Empty(changes)
+
+	assertions.Equal(strings.Join([]string{
+		"update-role grace@example.com: admin",
+		"invite ada@example.com: member",
+		"create-team team Editors",
+		"add-team-member ada@example.com to team Translators",
+		"add-team-member grace@example.com to team Editors",
+		"assign-space grace@example.com in id1: Editor",
+		"assign-space ada@example.com in id1: German translator",
+		"assign-team-space team Editors in id1: 5Dm0kOH8Xb8Pwq5fGrtK1n",
+		"unassign-team-space team Translators in id1",
+		"remove-team-member grace@example.com from team Translators",
+	}, "\n"), plan.String())
+}
+
+func TestReconciler_Plan_Paginated(t *testing.T) {
+	assertions := assert.New(t)
+
+	var changes []string
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, organizationHandler(&changes, 0), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	expected, err := access.New(cma, testutil.OrganizationId, nil).Plan(context.Background(), loadConfig(t))
+	assertions.Nil(err)
+
+	var skips []string
+
+	paged, pagedTs := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, organizationHandler(&changes, 1), func(r *http.Request) {
+		if r.URL.Path == "/organizations/org1/users" {
+			skips = append(skips, r.URL.Query().Get("skip"))
+		}
+	})
+
+	defer pagedTs.Close()
+
+	// members on later pages are neither removed nor reported by their user id
+	plan, err := access.New(paged, testutil.OrganizationId, nil).Plan(context.Background(), loadConfig(t))
+	assertions.Nil(err)
+	assertions.Equal([]string{"", "1"}, skips)
+	assertions.Equal(expected.String(), plan.String())
+	assertions.NotContains(plan.String(), "remove-member")
+	assertions.Empty(changes)
+}
+
+func TestReconciler_Apply(t *testing.T) {
+	assertions := assert.New(t)
+
+	var changes []string
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, organizationHandler(&changes, 0), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	reconciler := access.New(cma, testutil.OrganizationId, nil)
+
+	_, err := reconciler.Reconcile(context.Background(), loadConfig(t))
+	assertions.Nil(err)
+
+	role := func(id string) string {
+		return `{"sys":{"id":"` + id + `","type":"Link","linkType":"Role"}}`
+	}
+
+	// new members and teams are referred to by the ids returned when they are created
+	assertions.Equal([]string{
+		`PUT /organizations/org1/organization_memberships/1Pb0DM4d3MjHrsMijLYuPo {"role":"admin"}`,
+		`POST /organizations/org1/invitations {"firstName":"Ada","lastName":"Lovelace","email":"ada@example.com","role":"member"}`,
+		`POST /organizations/org1/teams {"name":"Editors"}`,
+		`POST /organizations/org1/teams/2wUg3XPSX2ZVUzM3tOIgKk/team_memberships {"organizationMembershipId":"6qDJ2ZQwS5j1r3vB0k9LxA"}`,
+		`POST /organizations/org1/teams/3kQwXJmZ5hY7ZQ0dDkL1vM/team_memberships {"organizationMembershipId":"1Pb0DM4d3MjHrsMijLYuPo"}`,
+		`PUT /spaces/id1/space_memberships/8960YTIOjg6jknUYIjhg {"sys":{"id":"8960YTIOjg6jknUYIjhg","type":"SpaceMembership","version":2},"admin":false,"roles":[` + role("5Dm0kOH8Xb8Pwq5fGrtK1n") + `],"user":{"sys":{"id":"4FLrUHftHW3v2BLi9fzfjU","type":"Link","linkType":"User"}}}`,
+		`POST /spaces/id1/space_memberships {"admin":false,"roles":[` + role("3fAs1qyhAZq8GptHdvAMHd") + `],"email":"ada@example.com"}`,
+		`POST /spaces/id1/team_space_memberships {"sys":{"team":{"sys":{"id":"3kQwXJmZ5hY7ZQ0dDkL1vM","type":"Link","linkType":"Team"}}},"admin":false,"roles":[` + role("5Dm0kOH8Xb8Pwq5fGrtK1n") + `]}`,
+		`DELETE /spaces/id1/team_space_memberships/6Ezg9h3P6CNI3w8bCoB6Ag`,
+		`DELETE /organizations/org1/teams/2wUg3XPSX2ZVUzM3tOIgKk/team_memberships/0QzsVlrhbVAeo5xaMJUzRp`,
+	}, changes)
+}
+
+func TestReconciler_LastAdmin(t *testing.T) {
+	assertions := assert.New(t)
+
+	var changes []string
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, organizationHandler(&changes, 0), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	config := loadConfig(t)
+	config.Spaces[0].Members[0] = access.SpaceAssignment{Email: "alan@example.com", Roles: []string{"Editor"}}
+
+	reconciler := access.New(cma, testutil.OrganizationId, nil)
+
+	_, err := reconciler.Reconcile(context.Background(), config)
+	assertions.ErrorIs(err, access.ErrLastAdmin)
+	assertions.Empty(changes)
+
+	// a team with members can be the admin of the space
+	config.Spaces[0].Teams[0] = access.SpaceAssignment{Team: "Editors", Admin: true}
+
+	plan, err := reconciler.Plan(context.Background(), config)
+	assertions.Nil(err)
+	assertions.Contains(plan.String(), "assign-team-space team Editors in id1: admin")
+
+	// the admin is demoted after the team is made admin
+	assertions.Equal("assign-space alan@example.com in id1: Editor", plan.Changes[len(plan.Changes)-3].String())
+}
+
+func TestReconciler_LastOwner(t *testing.T) {
+	assertions := assert.New(t)
+
+	var changes []string
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, organizationHandler(&changes, 0), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	reconciler := access.New(cma, testutil.OrganizationId, nil)
+
+	// an invited owner does not replace the current owner before accepting
+	config := loadConfig(t)
+	config.Members[0].Role = model.OrganizationRoleAdmin
+	config.Members[2].Role = model.OrganizationRoleOwner
+
+	_, err := reconciler.Reconcile(context.Background(), config)
+	assertions.ErrorIs(err, access.ErrLastAdmin)
+	assertions.ErrorContains(err, "alan@example.com is an owner")
+	assertions.Empty(changes)
+
+	config.Members = config.Members[1:]
+	config.Spaces[0].Members[0].Email = "grace@example.com"
+	config.Spaces[0].Members = config.Spaces[0].Members[:1]
+
+	_, err = reconciler.Plan(context.Background(), config)
+	assertions.ErrorIs(err, access.ErrLastAdmin)
+
+	// promoting a current member does not count until the promotion is applied
+	config = loadConfig(t)
+	config.Members[0].Role = model.OrganizationRoleAdmin
+	config.Members[1].Role = model.OrganizationRoleOwner
+
+	_, err = reconciler.Plan(context.Background(), config)
+	assertions.ErrorIs(err, access.ErrLastAdmin)
+}
+
+func TestReconciler_UnknownRole(t *testing.T) {
+	assertions := assert.New(t)
+
+	var changes []string
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, organizationHandler(&changes, 0), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	config := loadConfig(t)
+	config.Spaces[0].Members[1].Roles = []string{"Publisher"}
+
+	_, err := access.New(cma, testutil.OrganizationId, nil).Plan(context.Background(), config)
+	assertions.ErrorIs(err, access.ErrInvalidConfig)
+	assertions.ErrorContains(err, "space id1 has no role Publisher")
+}
+
+func TestParse(t *testing.T) {
+	assertions := assert.New(t)
+
+	config := loadConfig(t)
+	assertions.Len(config.Members, 3)
+	assertions.Equal([]string{"ada@example.com"}, config.Teams[0].Members)
+	assertions.True(config.Spaces[0].Members[0].Admin)
+
+	_, err := access.Parse([]byte(`{"members": [{"email": "ada@example.com"}]}`))
+	assertions.ErrorIs(err, access.ErrLastAdmin)
+
+	_, err = access.Parse([]byte(`
+members:
+  - email: alan@example.com
+    role: owner
+  - email: ALAN@example.com
+teams:
+  - name: Editors
+    members: [grace@example.com]
+spaces:
+  - id: id1
+    members:
+      - email: alan@example.com
+        admin: true
+        roles: [Editor]
+    teams:
+      - team: Translators
+`))
+	assertions.ErrorIs(err, access.ErrInvalidConfig)
+	assertions.ErrorContains(err, "member ALAN@example.com is listed twice")
+	assertions.ErrorContains(err, "team Editors has member grace@example.com which is not a member of the organization")
+	assertions.ErrorContains(err, "alan@example.com in space id1 is admin and has roles")
+	assertions.ErrorContains(err, "space id1 has team Translators which is not listed")
+	assertions.ErrorContains(err, "Translators in space id1 has no roles")
+}
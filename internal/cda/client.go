@@ -30,6 +30,10 @@ func New(config client.ClientConfig) (cda.SpaceIdClientBuilder, error) {
 		httpClient = &http.Client{}
 	}
 
+	if config.ResponseCache != nil {
+		httpClient = config.ResponseCache.Wrap(httpClient)
+	}
+
 	userAgent := config.UserAgent
 
 	if userAgent == nil {
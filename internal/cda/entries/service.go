@@ -0,0 +1,84 @@
+package entries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/internal/references"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cda"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cda.Entries = &entryService{}
+
+type entryService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (e entryService) Get(ctx context.Context, entryId string) (*model.Entry, error) {
+	res, err := e.client.Get(ctx, fmt.Sprintf("%s/%s", e.basePath, entryId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+	var entry model.Entry
+
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&entry)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (e entryService) List(ctx context.Context) cma.NextableCollection[*model.Entry, any] {
+	return cma2.NewCollection[*model.Entry, any](&cma2.CollectionOptions{
+		Path:   e.basePath,
+		Client: e.client,
+		Ctx:    ctx,
+	})
+}
+
+func (e entryService) References(ctx context.Context, entryId string, depth uint16) (*model.ReferenceGraph, error) {
+	// request all locales so the entries and assets match the localized models
+	query := url.Values{}
+	query.Set("locale", "*")
+
+	return references.GetReferences(ctx, e.client, e.basePath, entryId, depth, query)
+}
+
+func (e entryService) LinksToEntry(ctx context.Context, entryId string) ([]*model.Entry, error) {
+	return e.linksTo(ctx, model.LinkTypeEntry, entryId)
+}
+
+func (e entryService) LinksToAsset(ctx context.Context, assetId string) ([]*model.Entry, error) {
+	return e.linksTo(ctx, model.LinkTypeAsset, assetId)
+}
+
+func (e entryService) linksTo(ctx context.Context, linkType string, id string) ([]*model.Entry, error) {
+	collection := cma2.NewCollection[*model.Entry, any](&cma2.CollectionOptions{
+		Path:   e.basePath,
+		Client: e.client,
+		Ctx:    ctx,
+	})
+
+	err := references.FilterLinksTo(collection.GetQuery(), linkType, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return cma2.CollectAll[*model.Entry, any](collection)
+}
+
+func NewEntriesService(client common.RestClient) cda.Entries {
+	return &entryService{
+		client:   client,
+		basePath: "/entries",
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/labd/contentful-go/internal/cda/entries"
 	"github.com/labd/contentful-go/internal/cda/sync"
 	"github.com/labd/contentful-go/service/cda"
 	"github.com/labd/contentful-go/service/common"
@@ -38,3 +39,7 @@ func (c *EnvironmentClient) Delete(ctx context.Context, path string, queryParams
 func (c *EnvironmentClient) Sync() cda.Sync {
 	return sync.NewSyncService(c)
 }
+
+func (c *EnvironmentClient) Entries() cda.Entries {
+	return entries.NewEntriesService(c)
+}
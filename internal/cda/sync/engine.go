@@ -0,0 +1,194 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cda"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cda.SyncEngine = &syncEngine{}
+
+type syncEngine struct {
+	client      common.RestClient
+	basePath    string
+	store       cda.SyncStore
+	key         string
+	syncType    cda.SyncType
+	contentType *string
+}
+
+type syncPage struct {
+	Items       []json.RawMessage `json:"items"`
+	NextPageUrl string            `json:"nextPageUrl,omitempty"`
+	NextSyncUrl string            `json:"nextSyncUrl,omitempty"`
+}
+
+type syncItem struct {
+	Sys struct {
+		Type     string `json:"type"`
+		Revision int    `json:"revision"`
+	} `json:"sys"`
+}
+
+func (e *syncEngine) initialQuery() url.Values {
+	query := url.Values{}
+	query.Set("initial", "true")
+	query.Set("type", e.syncType.String())
+
+	if e.syncType == cda.Entry && e.contentType != nil {
+		query.Set("content_type", *e.contentType)
+		query.Set("limit", "100")
+	} else {
+		query.Set("limit", "1000")
+	}
+
+	return query
+}
+
+func (e *syncEngine) Run(ctx context.Context, handler cda.SyncHandler) error {
+	token, err := e.store.Load(ctx, e.key)
+	if err != nil {
+		return err
+	}
+
+	query := e.initialQuery()
+
+	// all pages of a sync started without a token belong to the initial sync, the
+	// token itself does not tell whether it continues an initial or a delta sync
+	initial := token == ""
+
+	for {
+		if token != "" {
+			query = url.Values{}
+			query.Set("sync_token", token)
+		}
+
+		res, err := e.client.Get(ctx, e.basePath, query, nil)
+		if err != nil {
+			return err
+		}
+
+		var page syncPage
+		err = json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Items {
+			event, err := newEvent(item, initial)
+			if err != nil {
+				return err
+			}
+
+			if event == nil {
+				continue
+			}
+
+			err = handler(ctx, event)
+			if err != nil {
+				return err
+			}
+		}
+
+		next := page.NextPageUrl
+		if next == "" {
+			next = page.NextSyncUrl
+		}
+
+		token, err = syncToken(next)
+		if err != nil {
+			return err
+		}
+
+		err = e.store.Save(ctx, e.key, token)
+		if err != nil {
+			return err
+		}
+
+		if page.NextPageUrl == "" {
+			return nil
+		}
+	}
+}
+
+func (e *syncEngine) Reset(ctx context.Context) error {
+	return e.store.Delete(ctx, e.key)
+}
+
+// newEvent returns the event of a sync item, or nil for unknown item types. Items
+// with revision 1 are created and other items are updated, except for items of an
+// initial sync, which are all created since they are new to the handler.
+func newEvent(data json.RawMessage, initial bool) (cda.SyncEvent, error) {
+	var item syncItem
+	err := json.Unmarshal(data, &item)
+	if err != nil {
+		return nil, err
+	}
+
+	created := item.Sys.Revision == 1 || initial
+
+	switch item.Sys.Type {
+	case "Entry":
+		var entry model.Entry
+		if err = json.Unmarshal(data, &entry); err != nil {
+			return nil, err
+		}
+
+		if created {
+			return cda.EntryCreated{Entry: &entry}, nil
+		}
+
+		return cda.EntryUpdated{Entry: &entry}, nil
+	case "Asset":
+		var asset model.Asset
+		if err = json.Unmarshal(data, &asset); err != nil {
+			return nil, err
+		}
+
+		if created {
+			return cda.AssetCreated{Asset: &asset}, nil
+		}
+
+		return cda.AssetUpdated{Asset: &asset}, nil
+	case "DeletedEntry", "DeletedAsset":
+		var deleted struct {
+			Sys *model.DeletedSys `json:"sys"`
+		}
+
+		if err = json.Unmarshal(data, &deleted); err != nil {
+			return nil, err
+		}
+
+		if item.Sys.Type == "DeletedEntry" {
+			return cda.EntryDeleted{Sys: deleted.Sys}, nil
+		}
+
+		return cda.AssetDeleted{Sys: deleted.Sys}, nil
+	}
+
+	return nil, nil
+}
+
+func syncToken(syncUrl string) (string, error) {
+	if syncUrl == "" {
+		return "", fmt.Errorf("sync response has no next page or sync url")
+	}
+
+	parsedUrl, err := url.Parse(syncUrl)
+	if err != nil {
+		return "", err
+	}
+
+	token := parsedUrl.Query().Get("sync_token")
+	if token == "" {
+		return "", fmt.Errorf("sync url %q has no sync token", syncUrl)
+	}
+
+	return token, nil
+}
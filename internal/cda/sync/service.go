@@ -39,6 +39,29 @@ func (s syncService) Init(ctx context.Context, syncType cda.SyncType, contentTyp
 	return collection
 }
 
+func (s syncService) Engine(store cda.SyncStore, syncType cda.SyncType, options *cda.SyncEngineOptions) cda.SyncEngine {
+	if options == nil {
+		options = &cda.SyncEngineOptions{}
+	}
+
+	key := options.Key
+	if key == "" {
+		key = syncType.String()
+		if options.ContentType != nil {
+			key += ":" + *options.ContentType
+		}
+	}
+
+	return &syncEngine{
+		client:      s.client,
+		basePath:    s.basePath,
+		store:       store,
+		key:         key,
+		syncType:    syncType,
+		contentType: options.ContentType,
+	}
+}
+
 func NewSyncService(client common.RestClient) cda.Sync {
 	return syncService{
 		client:   client,
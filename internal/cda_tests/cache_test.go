@@ -0,0 +1,180 @@
+package cda_tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/httpcache"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// cachedHandler serves the entry fixture with the given cache control and answers
+// matching If-None-Match requests with 304 Not Modified
+func cachedHandler(cacheControl string, requests *[]string) testutil.HTTPHandler {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Header.Get("If-None-Match"))
+
+		w.Header().Set("Cache-Control", cacheControl)
+		w.Header().Set("ETag", `"v1"`)
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintln(w, testutil.ReadTestData("entry/get.json"))
+	}
+}
+
+func TestResponseCache_Fresh(t *testing.T) {
+	assertions := assert.New(t)
+
+	var requests []string
+	responseCache := httpcache.New(httpcache.NewLRU(10))
+
+	cda, ts := testutil.MockCachedCDAClient(t, assertions, responseCache, testutil.ResponseData{}, cachedHandler("max-age=60", &requests), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	entries := cda.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries()
+
+	for i := 0; i < 3; i++ {
+		entry, err := entries.Get(context.Background(), "5KsDBWseXY6QegucYAoacS")
+		assertions.Nil(err)
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", entry.Sys.ID)
+	}
+
+	assertions.Equal([]string{""}, requests)
+
+	// other entries are cached by their own path
+	_, err := entries.Get(context.Background(), "other")
+	assertions.Nil(err)
+	assertions.Len(requests, 2)
+
+	responseCache.InvalidateEntity("5KsDBWseXY6QegucYAoacS")
+
+	_, err = entries.Get(context.Background(), "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal([]string{"", "", ""}, requests)
+}
+
+func TestResponseCache_Revalidate(t *testing.T) {
+	assertions := assert.New(t)
+
+	var requests []string
+	lru := httpcache.NewLRU(10)
+	responseCache := httpcache.New(lru)
+
+	cda, ts := testutil.MockCachedCDAClient(t, assertions, responseCache, testutil.ResponseData{}, cachedHandler("max-age=0", &requests), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	entries := cda.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries()
+
+	for i := 0; i < 3; i++ {
+		entry, err := entries.Get(context.Background(), "5KsDBWseXY6QegucYAoacS")
+		assertions.Nil(err)
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", entry.Sys.ID)
+	}
+
+	assertions.Equal([]string{"", `"v1"`, `"v1"`}, requests)
+	assertions.Equal(1, lru.Len())
+
+	responseCache.InvalidateAll()
+	assertions.Equal(0, lru.Len())
+}
+
+func TestResponseCache_NoStore(t *testing.T) {
+	assertions := assert.New(t)
+
+	var requests []string
+	lru := httpcache.NewLRU(10)
+
+	cda, ts := testutil.MockCachedCDAClient(t, assertions, httpcache.New(lru), testutil.ResponseData{}, cachedHandler("no-store", &requests), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	entries := cda.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries()
+
+	for i := 0; i < 2; i++ {
+		_, err := entries.Get(context.Background(), "5KsDBWseXY6QegucYAoacS")
+		assertions.Nil(err)
+	}
+
+	assertions.Equal([]string{"", ""}, requests)
+	assertions.Equal(0, lru.Len())
+}
+
+func TestLRU(t *testing.T) {
+	assertions := assert.New(t)
+
+	lru := httpcache.NewLRU(2)
+
+	lru.Set("a", &httpcache.Entry{StatusCode: http.StatusOK})
+	lru.Set("b", &httpcache.Entry{StatusCode: http.StatusOK})
+
+	// a is used more recently than b, so b is evicted
+	_, ok := lru.Get("a")
+	assertions.True(ok)
+
+	lru.Set("c", &httpcache.Entry{StatusCode: http.StatusOK})
+
+	_, ok = lru.Get("b")
+	assertions.False(ok)
+
+	_, ok = lru.Get("a")
+	assertions.True(ok)
+
+	lru.Delete("a")
+	assertions.Equal(1, lru.Len())
+}
+
+func TestLRU_OnEvict(t *testing.T) {
+	assertions := assert.New(t)
+
+	var evicted []string
+
+	lru := httpcache.NewLRU(1)
+	lru.OnEvict(func(key string) {
+		evicted = append(evicted, key)
+	})
+
+	lru.Set("a", &httpcache.Entry{StatusCode: http.StatusOK})
+	lru.Set("a", &httpcache.Entry{StatusCode: http.StatusOK})
+	lru.Set("b", &httpcache.Entry{StatusCode: http.StatusOK})
+	lru.Delete("b")
+
+	// only responses removed because the cache is full are reported
+	assertions.Equal([]string{"a"}, evicted)
+}
+
+func TestResponseCache_Eviction(t *testing.T) {
+	assertions := assert.New(t)
+
+	var requests []string
+	lru := httpcache.NewLRU(1)
+	responseCache := httpcache.New(lru)
+
+	cda, ts := testutil.MockCachedCDAClient(t, assertions, responseCache, testutil.ResponseData{}, cachedHandler("max-age=60", &requests), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	entries := cda.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries()
+
+	for i := 0; i < 5; i++ {
+		_, err := entries.Get(context.Background(), fmt.Sprintf("entry%d", i))
+		assertions.Nil(err)
+	}
+
+	assertions.Equal(1, lru.Len())
+	assertions.Equal(1, responseCache.Len())
+
+	responseCache.InvalidateEntity("5KsDBWseXY6QegucYAoacS")
+	assertions.Equal(0, lru.Len())
+	assertions.Equal(0, responseCache.Len())
+}
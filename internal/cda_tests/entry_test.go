@@ -0,0 +1,97 @@
+package cda_tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntryService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cda, ts := testutil.MockCDAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/entry/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/entries/5KsDBWseXY6QegucYAoacS", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	entry, err := cda.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries().Get(context.Background(), "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", entry.Sys.ID)
+}
+
+func TestEntryService_References(t *testing.T) {
+	assertions := assert.New(t)
+
+	cda, ts := testutil.MockCDAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/entry/references_delivery.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/entries/nyancat/references", r.URL.Path)
+		assertions.Equal("10", r.URL.Query().Get("include"))
+		assertions.Equal("*", r.URL.Query().Get("locale"))
+	})
+
+	defer ts.Close()
+
+	graph, err := cda.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries().References(context.Background(), "nyancat", 10)
+	assertions.Nil(err)
+	assertions.Equal("Nyan Cat", graph.Root.Fields["name"].(map[string]any)["en-US"])
+	assertions.Equal("Nyan Cat", graph.Assets["nyancatimage"].Fields.Title["en-US"])
+	assertions.Equal([]model.FieldLink{
+		{Field: "bestFriend", Locale: "en-US", LinkType: "Entry", ID: "happycat"},
+		{Field: "image", Locale: "en-US", LinkType: "Asset", ID: "nyancatimage"},
+	}, graph.Children("nyancat"))
+	assertions.Equal([]model.FieldLink{
+		{Field: "friends", Locale: "en-US", LinkType: "Entry", ID: "grumpycat"},
+	}, graph.Unresolved())
+}
+
+func TestEntryService_LinksToEntry(t *testing.T) {
+	assertions := assert.New(t)
+
+	cda, ts := testutil.MockCDAClient(t, assertions, testutil.ResponseData{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("skip") == "2" {
+			_, _ = w.Write([]byte(testutil.ReadTestData("/entry/links_to_page_2.json")))
+			return
+		}
+
+		_, _ = w.Write([]byte(testutil.ReadTestData("/entry/links_to_page_1.json")))
+	}, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/entries", r.URL.Path)
+		assertions.Equal("nyancat", r.URL.Query().Get("links_to_entry"))
+	})
+
+	defer ts.Close()
+
+	entries, err := cda.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries().LinksToEntry(context.Background(), "nyancat")
+	assertions.Nil(err)
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.Sys.ID)
+	}
+
+	assertions.Equal([]string{"a", "b", "c"}, ids)
+}
+
+func TestEntryService_LinksToAsset(t *testing.T) {
+	assertions := assert.New(t)
+
+	cda, ts := testutil.MockCDAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/entry/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/entries", r.URL.Path)
+		assertions.Equal("nyancatimage", r.URL.Query().Get("links_to_asset"))
+	})
+
+	defer ts.Close()
+
+	entries, err := cda.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries().LinksToAsset(context.Background(), "nyancatimage")
+	assertions.Nil(err)
+	assertions.Len(entries, 1)
+}
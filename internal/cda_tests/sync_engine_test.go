@@ -0,0 +1,224 @@
+package cda_tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/syncstore"
+	"github.com/labd/contentful-go/service/cda"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// syncHandler serves the sync fixtures based on the sync token of the request
+func syncHandler(tokens *[]string) testutil.HTTPHandler {
+	fixtures := map[string]string{
+		"":       "sync/initial_page_1.json",
+		"page2":  "sync/initial_page_2.json",
+		"delta1": "sync/delta.json",
+		"delta2": "sync/delta_2.json",
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("sync_token")
+		*tokens = append(*tokens, token)
+
+		fixture, ok := fixtures[token]
+		if !ok {
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintln(w, `{"sys": {"type": "Array"}, "items": [], "nextSyncUrl": "https://cdn.contentful.com/spaces/id1/environments/master/sync?sync_token=`+token+`"}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintln(w, testutil.ReadTestData(fixture))
+	}
+}
+
+func describeEvent(event cda.SyncEvent) string {
+	switch typed := event.(type) {
+	case cda.EntryCreated:
+		return "EntryCreated:" + typed.Entry.Sys.ID
+	case cda.EntryUpdated:
+		return "EntryUpdated:" + typed.Entry.Sys.ID
+	case cda.EntryDeleted:
+		return "EntryDeleted:" + typed.Sys.ID
+	case cda.AssetCreated:
+		return "AssetCreated:" + typed.Asset.Sys.ID
+	case cda.AssetUpdated:
+		return "AssetUpdated:" + typed.Asset.Sys.ID
+	case cda.AssetDeleted:
+		return "AssetDeleted:" + typed.Sys.ID
+	}
+
+	return "unknown"
+}
+
+func TestSyncEngine_Run(t *testing.T) {
+	assertions := assert.New(t)
+
+	var tokens []string
+
+	client, ts := testutil.MockCDAClient(t, assertions, testutil.ResponseData{}, syncHandler(&tokens), func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/sync", r.URL.Path)
+
+		if r.URL.Query().Get("sync_token") == "" {
+			assertions.Equal("true", r.URL.Query().Get("initial"))
+			assertions.Equal("all", r.URL.Query().Get("type"))
+		}
+	})
+
+	defer ts.Close()
+
+	store := syncstore.NewMemory()
+	engine := client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Sync().Engine(store, cda.All, nil)
+
+	var events []string
+	handler := func(ctx context.Context, event cda.SyncEvent) error {
+		events = append(events, describeEvent(event))
+		return nil
+	}
+
+	err := engine.Run(context.Background(), handler)
+	assertions.Nil(err)
+	assertions.Equal([]string{"", "page2"}, tokens)
+	assertions.Equal([]string{"EntryCreated:nyancat", "AssetCreated:nyancatimage", "EntryCreated:happycat"}, events)
+
+	token, err := store.Load(context.Background(), "all")
+	assertions.Nil(err)
+	assertions.Equal("delta1", token)
+
+	events = nil
+	err = engine.Run(context.Background(), handler)
+	assertions.Nil(err)
+	assertions.Equal([]string{"", "page2", "delta1"}, tokens)
+	assertions.Equal([]string{"EntryUpdated:nyancat", "EntryDeleted:happycat", "AssetDeleted:nyancatimage"}, events)
+
+	token, err = store.Load(context.Background(), "all")
+	assertions.Nil(err)
+	assertions.Equal("delta2", token)
+
+	// entries and assets published after the initial sync are created
+	events = nil
+	err = engine.Run(context.Background(), handler)
+	assertions.Nil(err)
+	assertions.Equal([]string{"", "page2", "delta1", "delta2"}, tokens)
+	assertions.Equal([]string{"EntryCreated:grumpycat", "AssetCreated:grumpycatimage", "EntryUpdated:nyancat"}, events)
+
+	token, err = store.Load(context.Background(), "all")
+	assertions.Nil(err)
+	assertions.Equal("delta3", token)
+}
+
+func TestSyncEngine_Run_ResumesAfterFailure(t *testing.T) {
+	assertions := assert.New(t)
+
+	var tokens []string
+
+	client, ts := testutil.MockCDAClient(t, assertions, testutil.ResponseData{}, syncHandler(&tokens), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "sync.json")
+	store := syncstore.NewFile(path)
+	engine := client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Sync().Engine(store, cda.All, &cda.SyncEngineOptions{
+		Key: "id1/master",
+	})
+
+	failure := errors.New("database unavailable")
+
+	// fail on the item of the second page, after the first page was stored
+	err := engine.Run(context.Background(), func(ctx context.Context, event cda.SyncEvent) error {
+		if describeEvent(event) == "EntryCreated:happycat" {
+			return failure
+		}
+
+		return nil
+	})
+	assertions.ErrorIs(err, failure)
+
+	token, err := store.Load(context.Background(), "id1/master")
+	assertions.Nil(err)
+	assertions.Equal("page2", token)
+
+	// a new engine on the same file continues with the second page
+	restarted := client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Sync().Engine(syncstore.NewFile(path), cda.All, &cda.SyncEngineOptions{
+		Key: "id1/master",
+	})
+
+	var events []string
+	err = restarted.Run(context.Background(), func(ctx context.Context, event cda.SyncEvent) error {
+		events = append(events, describeEvent(event))
+		return nil
+	})
+	assertions.Nil(err)
+	assertions.Equal([]string{"", "page2", "page2"}, tokens)
+	assertions.Equal([]string{"EntryUpdated:happycat"}, events)
+
+	err = restarted.Reset(context.Background())
+	assertions.Nil(err)
+
+	token, err = store.Load(context.Background(), "id1/master")
+	assertions.Nil(err)
+	assertions.Equal("", token)
+}
+
+func TestSyncEngine_Run_ContentType(t *testing.T) {
+	assertions := assert.New(t)
+
+	var tokens []string
+
+	client, ts := testutil.MockCDAClient(t, assertions, testutil.ResponseData{}, syncHandler(&tokens), func(r *http.Request) {
+		if r.URL.Query().Get("sync_token") == "" {
+			assertions.Equal("Entry", r.URL.Query().Get("type"))
+			assertions.Equal("cat", r.URL.Query().Get("content_type"))
+		}
+	})
+
+	defer ts.Close()
+
+	store := syncstore.NewMemory()
+	contentType := "cat"
+	engine := client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Sync().Engine(store, cda.Entry, &cda.SyncEngineOptions{
+		ContentType: &contentType,
+	})
+
+	err := engine.Run(context.Background(), func(ctx context.Context, event cda.SyncEvent) error {
+		return nil
+	})
+	assertions.Nil(err)
+
+	token, err := store.Load(context.Background(), "Entry:cat")
+	assertions.Nil(err)
+	assertions.Equal("delta1", token)
+}
+
+func TestMemory_ZeroValue(t *testing.T) {
+	assertions := assert.New(t)
+
+	var store syncstore.Memory
+
+	token, err := store.Load(context.Background(), "all")
+	assertions.Nil(err)
+	assertions.Equal("", token)
+
+	err = store.Save(context.Background(), "all", "delta1")
+	assertions.Nil(err)
+
+	token, err = store.Load(context.Background(), "all")
+	assertions.Nil(err)
+	assertions.Equal("delta1", token)
+
+	err = store.Delete(context.Background(), "all")
+	assertions.Nil(err)
+
+	token, err = store.Load(context.Background(), "all")
+	assertions.Nil(err)
+	assertions.Equal("", token)
+}
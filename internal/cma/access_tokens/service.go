@@ -0,0 +1,134 @@
+package access_tokens
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.AccessTokens = &accessTokensService{}
+
+type accessTokensService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (a *accessTokensService) Get(ctx context.Context, tokenId string) (*model.PersonalAccessToken, error) {
+	res, err := a.client.Get(ctx, fmt.Sprintf("%s/%s", a.basePath, tokenId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var token model.PersonalAccessToken
+
+	err = token.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (a *accessTokensService) List(ctx context.Context, filter *cma.AccessTokensFilter) cma.NextableCollection[*model.PersonalAccessToken, any] {
+	collection := cma2.NewCollection[*model.PersonalAccessToken, any](&cma2.CollectionOptions{
+		Path:   a.basePath,
+		Client: a.client,
+		Ctx:    ctx,
+	})
+
+	if filter == nil {
+		return collection
+	}
+
+	if filter.Revoked != nil && *filter.Revoked {
+		collection.GetQuery().Exists("revokedAt")
+	} else if filter.Revoked != nil {
+		collection.GetQuery().NotExists("revokedAt")
+	}
+
+	if filter.ExpiresBefore != nil {
+		collection.GetQuery().LessThan("sys.expiresAt", filter.ExpiresBefore.UTC())
+	}
+
+	return collection
+}
+
+func (a *accessTokensService) Create(ctx context.Context, token *model.PersonalAccessToken) error {
+	bytesArray, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	res, err := a.client.Post(ctx, a.basePath, nil, make(http.Header), bytes.NewReader(bytesArray))
+
+	if err != nil {
+		return err
+	}
+
+	return token.Decode(res.Body)
+}
+
+func (a *accessTokensService) Revoke(ctx context.Context, token *model.PersonalAccessToken) error {
+	res, err := a.client.Put(ctx, fmt.Sprintf("%s/%s/revoked", a.basePath, token.Sys.ID), nil, make(http.Header), nil)
+
+	if err != nil {
+		return err
+	}
+
+	return token.Decode(res.Body)
+}
+
+func (a *accessTokensService) CheckExpiry(ctx context.Context, logger *slog.Logger, within time.Duration, tokens ...string) ([]*model.PersonalAccessToken, error) {
+	active := false
+
+	existing, err := cma2.CollectAll(a.List(ctx, &cma.AccessTokensFilter{Revoked: &active}))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	var expiring []*model.PersonalAccessToken
+
+	for _, token := range existing {
+		if token.IsRevoked() || !token.ExpiresWithin(now, within) {
+			continue
+		}
+
+		for _, value := range tokens {
+			if !token.Matches(value) {
+				continue
+			}
+
+			expiring = append(expiring, token)
+
+			if logger != nil {
+				logger.WarnContext(ctx, "personal access token expires soon",
+					slog.String("id", token.Sys.ID),
+					slog.String("name", token.Name),
+					slog.Time("expiresAt", *token.ExpiresAt()),
+				)
+			}
+
+			break
+		}
+	}
+
+	return expiring, nil
+}
+
+func NewAccessTokensService(client common.RestClient) cma.AccessTokens {
+	return &accessTokensService{
+		client:   client,
+		basePath: "/users/me/access_tokens",
+	}
+}
@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/internal/cma/entries"
 	"github.com/labd/contentful-go/pkgs/model"
 	"github.com/labd/contentful-go/service/cma"
 	"github.com/labd/contentful-go/service/common"
@@ -19,6 +23,7 @@ var _ cma.Assets = &assetService{}
 
 type assetService struct {
 	client   common.RestClient
+	uploads  cma.Uploads
 	basePath string
 }
 
@@ -169,9 +174,158 @@ func (e assetService) Unarchive(ctx context.Context, asset *model.Asset) error {
 	return json.NewDecoder(res.Body).Decode(&asset)
 }
 
-func NewAssetService(client common.RestClient) cma.Assets {
+func (e assetService) Cascade(ctx context.Context, asset *model.Asset, options cma.CascadeOptions) (*cma.CascadeReport, error) {
+	var id string
+	if asset.Sys != nil {
+		id = asset.Sys.ID
+	}
+
+	return cma2.Cascade(ctx, entries.NewEntriesService(e.client), cma2.CascadeTarget{
+		Link: model.NewLink(model.LinkTypeAsset, id),
+		Sys:  asset.Sys,
+		Unpublish: func(ctx context.Context) error {
+			return e.Unpublish(ctx, asset)
+		},
+		Archive: func(ctx context.Context) error {
+			return e.Archive(ctx, asset)
+		},
+		Delete: func(ctx context.Context) error {
+			return e.Delete(ctx, asset)
+		},
+	}, options)
+}
+
+func (e assetService) CreateFromReader(ctx context.Context, reader io.Reader, metadata *cma.AssetMetadata, options *cma.CreateAssetOptions) (*model.Asset, error) {
+	if metadata == nil {
+		return nil, errors.New("asset metadata is required")
+	}
+
+	if options == nil {
+		options = &cma.CreateAssetOptions{}
+	}
+
+	locales := metadata.Locales
+	if len(locales) == 0 {
+		for locale := range metadata.Title {
+			locales = append(locales, locale)
+		}
+	}
+
+	if len(locales) == 0 {
+		return nil, errors.New("no locales given for the asset file")
+	}
+
+	upload, err := e.uploads.Create(ctx, reader, options.Upload)
+	if err != nil {
+		return nil, err
+	}
+
+	asset := &model.Asset{
+		Fields: &model.AssetFields{
+			Title:       metadata.Title,
+			Description: metadata.Description,
+			File:        map[string]*model.File{},
+		},
+	}
+
+	for _, locale := range locales {
+		asset.Fields.File[locale] = &model.File{
+			FileName:    metadata.FileName,
+			ContentType: metadata.ContentType,
+			UploadFrom:  upload.UploadFrom(),
+		}
+	}
+
+	err = e.Upsert(ctx, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.Process(ctx, asset)
+	if err != nil {
+		return asset, err
+	}
+
+	asset, err = e.waitForProcessing(ctx, asset, options)
+	if err != nil {
+		return asset, err
+	}
+
+	if options.Publish {
+		err = e.Publish(ctx, asset)
+		if err != nil {
+			return asset, err
+		}
+	}
+
+	return asset, nil
+}
+
+// waitForProcessing polls the asset with an exponential backoff until all files have an url
+func (e assetService) waitForProcessing(ctx context.Context, asset *model.Asset, options *cma.CreateAssetOptions) (*model.Asset, error) {
+	timeout := options.ProcessingTimeout
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
+
+	interval := options.PollInterval
+	if interval <= 0 {
+		interval = 500 * time.Millisecond
+	}
+
+	maxInterval := options.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = 10 * time.Second
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return asset, ctx.Err()
+		case <-deadline.C:
+			return asset, fmt.Errorf("asset %s: %w", asset.Sys.ID, cma.ErrProcessingTimeout)
+		case <-time.After(interval):
+		}
+
+		current, err := e.Get(ctx, asset.Sys.ID)
+		if err != nil {
+			return asset, err
+		}
+
+		asset = current
+
+		if isProcessed(asset) {
+			return asset, nil
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+func isProcessed(asset *model.Asset) bool {
+	if asset.Fields == nil || len(asset.Fields.File) == 0 {
+		return false
+	}
+
+	for _, file := range asset.Fields.File {
+		if file == nil || file.URL == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func NewAssetService(client common.RestClient, uploads cma.Uploads) cma.Assets {
 	return &assetService{
 		client:   client,
+		uploads:  uploads,
 		basePath: "/assets",
 	}
 }
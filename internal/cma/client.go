@@ -9,6 +9,9 @@ import (
 	"net/url"
 	"os"
 
+	"github.com/labd/contentful-go/internal/cma/access_tokens"
+	"github.com/labd/contentful-go/internal/cma/organizations"
+	"github.com/labd/contentful-go/internal/cma/spaces"
 	internalcommon "github.com/labd/contentful-go/internal/common"
 	"github.com/labd/contentful-go/pkgs/client"
 	"github.com/labd/contentful-go/pkgs/util"
@@ -19,7 +22,8 @@ import (
 var _ cma.SpaceIdClientBuilder = &Client{}
 
 type Client struct {
-	client *internalcommon.Client
+	client       *internalcommon.Client
+	uploadClient *internalcommon.Client
 }
 
 func New(config client.ClientConfig) (cma.SpaceIdClientBuilder, error) {
@@ -47,6 +51,17 @@ func New(config client.ClientConfig) (cma.SpaceIdClientBuilder, error) {
 		return nil, err
 	}
 
+	uploadUrl := config.UploadURL
+
+	if uploadUrl == nil {
+		uploadUrl = util.ToPointer("https://upload.contentful.com")
+	}
+
+	parsedUploadURL, err := url.Parse(*uploadUrl)
+	if err != nil {
+		return nil, err
+	}
+
 	logger := config.Logger
 
 	if logger == nil {
@@ -65,6 +80,16 @@ func New(config client.ClientConfig) (cma.SpaceIdClientBuilder, error) {
 			Token:       config.Token,
 			Logger:      logger,
 		}),
+		uploadClient: internalcommon.NewInternalClient(internalcommon.ClientConfig{
+			URL:         parsedUploadURL,
+			HTTPClient:  httpClient,
+			Debug:       false,
+			UserAgent:   *userAgent,
+			ContentType: "application/octet-stream",
+			Token:       config.Token,
+			Logger:      logger,
+			Streaming:   true,
+		}),
 	}, nil
 }
 
@@ -97,3 +122,15 @@ func (c *Client) Put(ctx context.Context, path string, queryParams url.Values, h
 func (c *Client) Delete(ctx context.Context, path string, queryParams url.Values, headers http.Header) (*http.Response, error) {
 	return c.client.Delete(ctx, path, queryParams, headers)
 }
+
+func (c *Client) AccessTokens() cma.AccessTokens {
+	return access_tokens.NewAccessTokensService(c)
+}
+
+func (c *Client) Spaces() cma.Spaces {
+	return spaces.NewSpacesService(c)
+}
+
+func (c *Client) Organizations() cma.Organizations {
+	return organizations.NewOrganizationsService(c)
+}
@@ -0,0 +1,144 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+)
+
+// CascadeTarget describes the entry or asset a cascade operates on
+type CascadeTarget struct {
+	Link      model.Link
+	Sys       *model.PublishSys
+	Unpublish func(ctx context.Context) error
+	Archive   func(ctx context.Context) error
+	Delete    func(ctx context.Context) error
+}
+
+// Cascade handles incoming links of the target according to the options, unpublishes
+// the target when needed and archives or deletes it
+func Cascade(ctx context.Context, entries cma.Entries, target CascadeTarget, options cma.CascadeOptions) (*cma.CascadeReport, error) {
+	report := &cma.CascadeReport{
+		DryRun: options.DryRun,
+		Target: target.Link,
+		Action: options.Action,
+	}
+
+	if target.Sys == nil || target.Sys.ID == "" {
+		return report, fmt.Errorf("cannot %s an entity without id", options.Action)
+	}
+
+	var referencing []*model.Entry
+	var err error
+
+	if target.Link.Sys.LinkType == model.LinkTypeEntry {
+		referencing, err = entries.LinksToEntry(ctx, target.Link.Sys.ID)
+	} else {
+		referencing, err = entries.LinksToAsset(ctx, target.Link.Sys.ID)
+	}
+
+	if err != nil {
+		return report, err
+	}
+
+	for _, entry := range referencing {
+		if entry.Sys == nil || (target.Link.Sys.LinkType == model.LinkTypeEntry && entry.Sys.ID == target.Link.Sys.ID) {
+			continue
+		}
+
+		reference := &cma.CascadeReference{Entry: entry}
+
+		for _, link := range entry.Links() {
+			if link.LinkType == target.Link.Sys.LinkType && link.ID == target.Link.Sys.ID {
+				reference.Links = append(reference.Links, link)
+			}
+		}
+
+		report.References = append(report.References, reference)
+	}
+
+	if len(report.References) > 0 && options.References == cma.ReferencesAbort {
+		return report, fmt.Errorf("cannot %s %s %s: %w", options.Action, target.Link.Sys.LinkType, target.Link.Sys.ID, cma.ErrEntityReferenced)
+	}
+
+	if options.References == cma.ReferencesRemove {
+		for _, reference := range report.References {
+			err = removeReference(ctx, entries, reference, target.Link, options.DryRun)
+			if err != nil {
+				return report, err
+			}
+		}
+	}
+
+	if target.Sys.PublishedVersion > 0 {
+		if !options.DryRun {
+			err = target.Unpublish(ctx)
+			if err != nil {
+				return report, err
+			}
+		}
+
+		report.Unpublished = true
+	}
+
+	switch options.Action {
+	case cma.CascadeArchive:
+		if target.Sys.ArchivedVersion > 0 {
+			return report, nil
+		}
+
+		if !options.DryRun {
+			err = target.Archive(ctx)
+			if err != nil {
+				return report, err
+			}
+		}
+
+		report.Archived = true
+	case cma.CascadeDelete:
+		if !options.DryRun {
+			err = target.Delete(ctx)
+			if err != nil {
+				return report, err
+			}
+		}
+
+		report.Deleted = true
+	}
+
+	return report, nil
+}
+
+func removeReference(ctx context.Context, entries cma.Entries, reference *cma.CascadeReference, target model.Link, dryRun bool) error {
+	entry := reference.Entry
+
+	// only republish entries without pending changes, to not publish drafts of other editors
+	republish := entry.Sys.PublishedVersion > 0 && entry.IsPublished()
+
+	if !dryRun {
+		if entry.Sys.ContentType == nil {
+			return fmt.Errorf("entry %s has no content type", entry.Sys.ID)
+		}
+
+		entry.RemoveLinks(target.Sys.LinkType, target.Sys.ID)
+
+		err := entries.Upsert(ctx, entry.Sys.ContentType.Sys.ID, entry)
+		if err != nil {
+			return err
+		}
+
+		if republish {
+			err = entries.Publish(ctx, entry)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	reference.Removed = true
+	reference.Republished = republish
+
+	return nil
+}
@@ -37,7 +37,7 @@ func NewCollection[Items any, Includes any](options *CollectionOptions) *Collect
 	}
 }
 
-func (col Collection[Items, Includes]) Next() (*common.Collection[Items, Includes], error) {
+func (col *Collection[Items, Includes]) Next() (*common.Collection[Items, Includes], error) {
 	// setup query params
 	skip := uint16(col.Limit) * (col.page - 1)
 	col.Query.Skip(skip)
@@ -50,6 +50,12 @@ func (col Collection[Items, Includes]) Next() (*common.Collection[Items, Include
 
 	col.page++
 
+	// the items of the previous page may still be referenced by the caller, decoding
+	// into them would overwrite those
+	var includes Includes
+	col.Collection.Items = nil
+	col.Collection.Includes = includes
+
 	defer res.Body.Close()
 	err = json.NewDecoder(res.Body).Decode(&col.Collection)
 	if err != nil {
@@ -58,6 +64,24 @@ func (col Collection[Items, Includes]) Next() (*common.Collection[Items, Include
 	return &col.Collection, nil
 }
 
-func (col Collection[Items, Includes]) GetQuery() *common.Query {
+func (col *Collection[Items, Includes]) GetQuery() *common.Query {
 	return col.Query
 }
+
+// CollectAll walks all pages of the given collection and returns the combined items
+func CollectAll[Items any, Includes any](collection cma.NextableCollection[Items, Includes]) ([]Items, error) {
+	var items []Items
+
+	for {
+		page, err := collection.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Items...)
+
+		if len(page.Items) == 0 || page.Skip+len(page.Items) >= page.Total {
+			return items, nil
+		}
+	}
+}
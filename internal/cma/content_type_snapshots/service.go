@@ -0,0 +1,47 @@
+package content_type_snapshots
+
+import (
+	"context"
+	"fmt"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.ContentTypeSnapshots = &contentTypeSnapshotsService{}
+
+type contentTypeSnapshotsService struct {
+	client common.RestClient
+}
+
+func (c *contentTypeSnapshotsService) Get(ctx context.Context, contentTypeId string, snapshotId string) (*model.ContentTypeSnapshot, error) {
+	res, err := c.client.Get(ctx, fmt.Sprintf("/content_types/%s/snapshots/%s", contentTypeId, snapshotId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot model.ContentTypeSnapshot
+
+	err = snapshot.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+func (c *contentTypeSnapshotsService) List(ctx context.Context, contentTypeId string) cma.NextableCollection[*model.ContentTypeSnapshot, any] {
+	return cma2.NewCollection[*model.ContentTypeSnapshot, any](&cma2.CollectionOptions{
+		Path:   fmt.Sprintf("/content_types/%s/snapshots", contentTypeId),
+		Client: c.client,
+		Ctx:    ctx,
+	})
+}
+
+func NewContentTypeSnapshotsService(client common.RestClient) cma.ContentTypeSnapshots {
+	return &contentTypeSnapshotsService{
+		client: client,
+	}
+}
@@ -0,0 +1,76 @@
+package editor_interfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.EditorInterfaces = &editorInterfacesService{}
+
+type editorInterfacesService struct {
+	client common.RestClient
+}
+
+func (e *editorInterfacesService) Get(ctx context.Context, contentTypeId string) (*model.EditorInterface, error) {
+	res, err := e.client.Get(ctx, fmt.Sprintf("/content_types/%s/editor_interface", contentTypeId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var editorInterface model.EditorInterface
+
+	err = editorInterface.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &editorInterface, nil
+}
+
+func (e *editorInterfacesService) List(ctx context.Context) cma.NextableCollection[*model.EditorInterface, any] {
+	return cma2.NewCollection[*model.EditorInterface, any](&cma2.CollectionOptions{
+		Path:   "/editor_interfaces",
+		Client: e.client,
+		Ctx:    ctx,
+	})
+}
+
+func (e *editorInterfacesService) Update(ctx context.Context, editorInterface *model.EditorInterface) error {
+	contentTypeId := editorInterface.ContentTypeId()
+	if contentTypeId == "" {
+		return errors.New("editor interface has no content type")
+	}
+
+	bytesArray, err := json.Marshal(editorInterface)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(editorInterface.GetVersion()))
+
+	res, err := e.client.Put(ctx, fmt.Sprintf("/content_types/%s/editor_interface", contentTypeId), nil, headers, bytes.NewReader(bytesArray))
+
+	if err != nil {
+		return err
+	}
+
+	return editorInterface.Decode(res.Body)
+}
+
+func NewEditorInterfacesService(client common.RestClient) cma.EditorInterfaces {
+	return &editorInterfacesService{
+		client: client,
+	}
+}
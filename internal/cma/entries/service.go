@@ -6,9 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 
 	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/internal/cma/entry_snapshots"
+	"github.com/labd/contentful-go/internal/references"
 	"github.com/labd/contentful-go/pkgs/model"
 	"github.com/labd/contentful-go/service/cma"
 	"github.com/labd/contentful-go/service/common"
@@ -148,6 +151,118 @@ func (e entryService) Unarchive(ctx context.Context, entry *model.Entry) error {
 	return json.NewDecoder(res.Body).Decode(&entry)
 }
 
+func (e entryService) References(ctx context.Context, entryId string, depth uint16) (*model.ReferenceGraph, error) {
+	return references.GetReferences(ctx, e.client, e.basePath, entryId, depth, nil)
+}
+
+func (e entryService) LinksToEntry(ctx context.Context, entryId string) ([]*model.Entry, error) {
+	return e.linksTo(ctx, model.LinkTypeEntry, entryId)
+}
+
+func (e entryService) LinksToAsset(ctx context.Context, assetId string) ([]*model.Entry, error) {
+	return e.linksTo(ctx, model.LinkTypeAsset, assetId)
+}
+
+func (e entryService) Cascade(ctx context.Context, entry *model.Entry, options cma.CascadeOptions) (*cma.CascadeReport, error) {
+	var id string
+	if entry.Sys != nil {
+		id = entry.Sys.ID
+	}
+
+	return cma2.Cascade(ctx, e, cma2.CascadeTarget{
+		Link: model.NewLink(model.LinkTypeEntry, id),
+		Sys:  entry.Sys,
+		Unpublish: func(ctx context.Context) error {
+			return e.Unpublish(ctx, entry)
+		},
+		Archive: func(ctx context.Context) error {
+			return e.Archive(ctx, entry)
+		},
+		Delete: func(ctx context.Context) error {
+			return e.Delete(ctx, entry)
+		},
+	}, options)
+}
+
+func (e entryService) Restore(ctx context.Context, entryId string, snapshotId string, options cma.RestoreOptions) (*cma.RestoreReport, error) {
+	report := &cma.RestoreReport{DryRun: options.DryRun}
+
+	snapshot, err := entry_snapshots.NewEntrySnapshotsService(e.client).Get(ctx, entryId, snapshotId)
+	if err != nil {
+		return report, err
+	}
+
+	report.Snapshot = snapshot
+
+	// the update is sent with the version of this entry, so edits made in the meantime
+	// make the restore fail instead of being overwritten
+	entry, err := e.Get(ctx, entryId)
+	if err != nil {
+		return report, err
+	}
+
+	report.Entry = entry
+
+	for _, change := range model.DiffEntries(entry, snapshot.Snapshot) {
+		if len(options.Fields) > 0 && !slices.Contains(options.Fields, change.Field) {
+			continue
+		}
+
+		if len(options.Locales) > 0 && !slices.Contains(options.Locales, change.Locale) {
+			continue
+		}
+
+		report.Changes = append(report.Changes, change)
+	}
+
+	if entry.Sys == nil || entry.Sys.ContentType == nil {
+		return report, fmt.Errorf("entry %s has no content type", entryId)
+	}
+
+	if options.DryRun {
+		report.Updated = len(report.Changes) > 0
+		report.Published = options.Publish
+		return report, nil
+	}
+
+	if len(report.Changes) > 0 {
+		entry.ApplyChanges(report.Changes...)
+
+		err = e.Upsert(ctx, entry.Sys.ContentType.Sys.ID, entry)
+		if err != nil {
+			return report, err
+		}
+
+		report.Updated = true
+	}
+
+	if options.Publish {
+		err = e.Publish(ctx, entry)
+		if err != nil {
+			return report, err
+		}
+
+		report.Published = true
+	}
+
+	return report, nil
+}
+
+func (e entryService) linksTo(ctx context.Context, linkType string, id string) ([]*model.Entry, error) {
+	collection := cma2.NewCollection[*model.Entry, any](&cma2.CollectionOptions{
+		Path:   e.basePath,
+		Client: e.client,
+		Ctx:    ctx,
+	})
+
+	err := references.FilterLinksTo(collection.GetQuery(), linkType, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return cma2.CollectAll[*model.Entry, any](collection)
+}
+
 func NewEntriesService(client common.RestClient) cma.Entries {
 	return &entryService{
 		client:   client,
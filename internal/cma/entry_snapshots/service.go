@@ -0,0 +1,47 @@
+package entry_snapshots
+
+import (
+	"context"
+	"fmt"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.EntrySnapshots = &entrySnapshotsService{}
+
+type entrySnapshotsService struct {
+	client common.RestClient
+}
+
+func (e *entrySnapshotsService) Get(ctx context.Context, entryId string, snapshotId string) (*model.EntrySnapshot, error) {
+	res, err := e.client.Get(ctx, fmt.Sprintf("/entries/%s/snapshots/%s", entryId, snapshotId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot model.EntrySnapshot
+
+	err = snapshot.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+func (e *entrySnapshotsService) List(ctx context.Context, entryId string) cma.NextableCollection[*model.EntrySnapshot, any] {
+	return cma2.NewCollection[*model.EntrySnapshot, any](&cma2.CollectionOptions{
+		Path:   fmt.Sprintf("/entries/%s/snapshots", entryId),
+		Client: e.client,
+		Ctx:    ctx,
+	})
+}
+
+func NewEntrySnapshotsService(client common.RestClient) cma.EntrySnapshots {
+	return &entrySnapshotsService{
+		client: client,
+	}
+}
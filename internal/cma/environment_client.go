@@ -9,9 +9,14 @@ import (
 
 	"github.com/labd/contentful-go/internal/cma/app_installations"
 	"github.com/labd/contentful-go/internal/cma/assets"
+	"github.com/labd/contentful-go/internal/cma/content_type_snapshots"
 	"github.com/labd/contentful-go/internal/cma/content_types"
+	"github.com/labd/contentful-go/internal/cma/editor_interfaces"
 	"github.com/labd/contentful-go/internal/cma/entries"
+	"github.com/labd/contentful-go/internal/cma/entry_snapshots"
+	"github.com/labd/contentful-go/internal/cma/extensions"
 	"github.com/labd/contentful-go/internal/cma/locales"
+	"github.com/labd/contentful-go/internal/cma/scheduled_actions"
 	"github.com/labd/contentful-go/service/cma"
 	"github.com/labd/contentful-go/service/common"
 )
@@ -20,6 +25,7 @@ var _ cma.EnvironmentClient = &EnvironmentClient{}
 
 type EnvironmentClient struct {
 	client      common.RestClient
+	uploads     cma.Uploads
 	environment string
 }
 
@@ -48,7 +54,7 @@ func (c *EnvironmentClient) Entries() cma.Entries {
 }
 
 func (c *EnvironmentClient) Assets() cma.Assets {
-	return assets.NewAssetService(c)
+	return assets.NewAssetService(c, c.uploads)
 }
 func (c *EnvironmentClient) ContentTypes() cma.ContentTypes {
 	return content_types.NewContentTypeService(c)
@@ -57,3 +63,23 @@ func (c *EnvironmentClient) ContentTypes() cma.ContentTypes {
 func (c *EnvironmentClient) Locales() cma.Locales {
 	return locales.NewLocaleService(c)
 }
+
+func (c *EnvironmentClient) ScheduledActions() cma.ScheduledActions {
+	return scheduled_actions.NewScheduledActionsService(c.client, c.environment)
+}
+
+func (c *EnvironmentClient) EditorInterfaces() cma.EditorInterfaces {
+	return editor_interfaces.NewEditorInterfacesService(c)
+}
+
+func (c *EnvironmentClient) Extensions() cma.Extensions {
+	return extensions.NewExtensionsService(c)
+}
+
+func (c *EnvironmentClient) EntrySnapshots() cma.EntrySnapshots {
+	return entry_snapshots.NewEntrySnapshotsService(c)
+}
+
+func (c *EnvironmentClient) ContentTypeSnapshots() cma.ContentTypeSnapshots {
+	return content_type_snapshots.NewContentTypeSnapshotsService(c)
+}
@@ -0,0 +1,94 @@
+package extensions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.Extensions = &extensionsService{}
+
+type extensionsService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (e *extensionsService) Get(ctx context.Context, extensionId string) (*model.Extension, error) {
+	res, err := e.client.Get(ctx, fmt.Sprintf("%s/%s", e.basePath, extensionId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var extension model.Extension
+
+	err = extension.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &extension, nil
+}
+
+func (e *extensionsService) List(ctx context.Context) cma.NextableCollection[*model.Extension, any] {
+	return cma2.NewCollection[*model.Extension, any](&cma2.CollectionOptions{
+		Path:   e.basePath,
+		Client: e.client,
+		Ctx:    ctx,
+	})
+}
+
+// Upsert updates or creates a new extension
+func (e *extensionsService) Upsert(ctx context.Context, extension *model.Extension) error {
+	err := extension.ValidateInstallationParameters()
+	if err != nil {
+		return err
+	}
+
+	bytesArray, err := json.Marshal(extension)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(extension.GetVersion()))
+
+	var res *http.Response
+
+	if extension.IsNew() {
+		res, err = e.client.Post(ctx, e.basePath, nil, headers, bytes.NewReader(bytesArray))
+	} else {
+		res, err = e.client.Put(ctx, fmt.Sprintf("%s/%s", e.basePath, extension.Sys.ID), nil, headers, bytes.NewReader(bytesArray))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return extension.Decode(res.Body)
+}
+
+func (e *extensionsService) Delete(ctx context.Context, extension *model.Extension) error {
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(extension.GetVersion()))
+
+	_, err := e.client.Delete(ctx, fmt.Sprintf("%s/%s", e.basePath, extension.Sys.ID), nil, headers)
+
+	return err
+}
+
+func NewExtensionsService(client common.RestClient) cma.Extensions {
+	return &extensionsService{
+		client:   client,
+		basePath: "/extensions",
+	}
+}
@@ -0,0 +1,69 @@
+package invitations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.Invitations = &invitationsService{}
+
+// the invitations endpoint is only enabled with this alpha feature
+const alphaFeature = "pending-org-membership"
+
+type invitationsService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (i *invitationsService) Get(ctx context.Context, invitationId string) (*model.Invitation, error) {
+	res, err := i.client.Get(ctx, fmt.Sprintf("%s/%s", i.basePath, invitationId), nil, headers())
+
+	if err != nil {
+		return nil, err
+	}
+
+	var invitation model.Invitation
+
+	err = invitation.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+func (i *invitationsService) Create(ctx context.Context, invitation *model.Invitation) error {
+	bytesArray, err := json.Marshal(invitation)
+	if err != nil {
+		return err
+	}
+
+	res, err := i.client.Post(ctx, i.basePath, nil, headers(), bytes.NewReader(bytesArray))
+
+	if err != nil {
+		return err
+	}
+
+	return invitation.Decode(res.Body)
+}
+
+func headers() http.Header {
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Enable-Alpha-Feature", alphaFeature)
+
+	return headers
+}
+
+func NewInvitationsService(client common.RestClient) cma.Invitations {
+	return &invitationsService{
+		client:   client,
+		basePath: "/invitations",
+	}
+}
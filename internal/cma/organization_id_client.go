@@ -8,6 +8,11 @@ import (
 	"net/url"
 
 	"github.com/labd/contentful-go/internal/cma/app_definitions"
+	"github.com/labd/contentful-go/internal/cma/invitations"
+	"github.com/labd/contentful-go/internal/cma/organization_memberships"
+	"github.com/labd/contentful-go/internal/cma/team_memberships"
+	"github.com/labd/contentful-go/internal/cma/teams"
+	"github.com/labd/contentful-go/internal/cma/users"
 	"github.com/labd/contentful-go/service/cma"
 )
 
@@ -37,3 +42,23 @@ func (c *OrganizationIdClient) Delete(ctx context.Context, path string, queryPar
 func (c *OrganizationIdClient) AppDefinitions() cma.AppDefinitions {
 	return app_definitions.NewAppDefinitionService(c)
 }
+
+func (c *OrganizationIdClient) OrganizationMemberships() cma.OrganizationMemberships {
+	return organization_memberships.NewOrganizationMembershipsService(c)
+}
+
+func (c *OrganizationIdClient) Invitations() cma.Invitations {
+	return invitations.NewInvitationsService(c)
+}
+
+func (c *OrganizationIdClient) Teams() cma.Teams {
+	return teams.NewTeamsService(c)
+}
+
+func (c *OrganizationIdClient) TeamMemberships() cma.TeamMemberships {
+	return team_memberships.NewTeamMembershipsService(c)
+}
+
+func (c *OrganizationIdClient) Users() cma.Users {
+	return users.NewUsersService(c)
+}
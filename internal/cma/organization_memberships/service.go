@@ -0,0 +1,78 @@
+package organization_memberships
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.OrganizationMemberships = &organizationMembershipsService{}
+
+type organizationMembershipsService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (o *organizationMembershipsService) Get(ctx context.Context, membershipId string) (*model.OrganizationMembership, error) {
+	res, err := o.client.Get(ctx, fmt.Sprintf("%s/%s", o.basePath, membershipId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var membership model.OrganizationMembership
+
+	err = membership.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &membership, nil
+}
+
+func (o *organizationMembershipsService) List(ctx context.Context) cma.NextableCollection[*model.OrganizationMembership, any] {
+	return cma2.NewCollection[*model.OrganizationMembership, any](&cma2.CollectionOptions{
+		Path:   o.basePath,
+		Client: o.client,
+		Ctx:    ctx,
+	})
+}
+
+func (o *organizationMembershipsService) Update(ctx context.Context, membership *model.OrganizationMembership) error {
+	bytesArray, err := json.Marshal(map[string]string{"role": membership.Role})
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(membership.GetVersion()))
+
+	res, err := o.client.Put(ctx, fmt.Sprintf("%s/%s", o.basePath, membership.Sys.ID), nil, headers, bytes.NewReader(bytesArray))
+
+	if err != nil {
+		return err
+	}
+
+	return membership.Decode(res.Body)
+}
+
+func (o *organizationMembershipsService) Delete(ctx context.Context, membership *model.OrganizationMembership) error {
+	_, err := o.client.Delete(ctx, fmt.Sprintf("%s/%s", o.basePath, membership.Sys.ID), nil, make(http.Header))
+
+	return err
+}
+
+func NewOrganizationMembershipsService(client common.RestClient) cma.OrganizationMemberships {
+	return &organizationMembershipsService{
+		client:   client,
+		basePath: "/organization_memberships",
+	}
+}
@@ -0,0 +1,32 @@
+package organizations
+
+import (
+	"context"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.Organizations = &organizationsService{}
+
+type organizationsService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (o *organizationsService) List(ctx context.Context) cma.NextableCollection[*model.Organization, any] {
+	return cma2.NewCollection[*model.Organization, any](&cma2.CollectionOptions{
+		Path:   o.basePath,
+		Client: o.client,
+		Ctx:    ctx,
+	})
+}
+
+func NewOrganizationsService(client common.RestClient) cma.Organizations {
+	return &organizationsService{
+		client:   client,
+		basePath: "/organizations",
+	}
+}
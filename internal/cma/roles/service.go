@@ -0,0 +1,89 @@
+package roles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.Roles = &rolesService{}
+
+type rolesService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (r *rolesService) Get(ctx context.Context, roleId string) (*model.Role, error) {
+	res, err := r.client.Get(ctx, fmt.Sprintf("%s/%s", r.basePath, roleId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var role model.Role
+
+	err = role.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+func (r *rolesService) List(ctx context.Context) cma.NextableCollection[*model.Role, any] {
+	return cma2.NewCollection[*model.Role, any](&cma2.CollectionOptions{
+		Path:   r.basePath,
+		Client: r.client,
+		Ctx:    ctx,
+	})
+}
+
+// Upsert updates or creates a new role entity
+func (r *rolesService) Upsert(ctx context.Context, role *model.Role) error {
+	bytesArray, err := json.Marshal(role)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(role.GetVersion()))
+
+	var res *http.Response
+
+	if role.IsNew() {
+		res, err = r.client.Post(ctx, r.basePath, nil, headers, bytes.NewReader(bytesArray))
+	} else {
+		res, err = r.client.Put(ctx, fmt.Sprintf("%s/%s", r.basePath, role.Sys.ID), nil, headers, bytes.NewReader(bytesArray))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return role.Decode(res.Body)
+}
+
+func (r *rolesService) Delete(ctx context.Context, role *model.Role) error {
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(role.GetVersion()))
+
+	_, err := r.client.Delete(ctx, fmt.Sprintf("%s/%s", r.basePath, role.Sys.ID), nil, headers)
+
+	return err
+}
+
+func NewRolesService(client common.RestClient) cma.Roles {
+	return &rolesService{
+		client:   client,
+		basePath: "/roles",
+	}
+}
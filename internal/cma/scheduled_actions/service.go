@@ -0,0 +1,143 @@
+package scheduled_actions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.ScheduledActions = &scheduledActionsService{}
+
+// scheduled actions live on the space level and are scoped to an environment through the query
+type scheduledActionsService struct {
+	client      common.RestClient
+	environment string
+	basePath    string
+}
+
+func (s *scheduledActionsService) environmentQuery() url.Values {
+	query := url.Values{}
+	query.Set("environment.sys.id", s.environment)
+	return query
+}
+
+func (s *scheduledActionsService) Get(ctx context.Context, scheduledActionId string) (*model.ScheduledAction, error) {
+	res, err := s.client.Get(ctx, fmt.Sprintf("%s/%s", s.basePath, scheduledActionId), s.environmentQuery(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduledAction model.ScheduledAction
+
+	err = scheduledAction.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &scheduledAction, nil
+}
+
+func (s *scheduledActionsService) List(ctx context.Context, filter *cma.ScheduledActionsFilter) cma.NextableCollection[*model.ScheduledAction, any] {
+	collection := cma2.NewCollection[*model.ScheduledAction, any](&cma2.CollectionOptions{
+		Path:   s.basePath,
+		Client: s.client,
+		Ctx:    ctx,
+	})
+
+	collection.GetQuery().Equal("environment.sys.id", s.environment)
+
+	if filter == nil {
+		return collection
+	}
+
+	if len(filter.EntityIds) == 1 {
+		collection.GetQuery().Equal("entity.sys.id", filter.EntityIds[0])
+	} else if len(filter.EntityIds) > 1 {
+		collection.GetQuery().In("entity.sys.id", filter.EntityIds)
+	}
+
+	if len(filter.Status) > 0 {
+		var status []string
+		for _, value := range filter.Status {
+			status = append(status, string(value))
+		}
+
+		collection.GetQuery().In("sys.status", status)
+	}
+
+	return collection
+}
+
+func (s *scheduledActionsService) Upsert(ctx context.Context, scheduledAction *model.ScheduledAction) error {
+	if scheduledAction.Environment.Sys.ID == "" {
+		scheduledAction.Environment.Sys = model.BaseSys{
+			ID:       s.environment,
+			Type:     "Link",
+			LinkType: "Environment",
+		}
+	}
+
+	bytesArray, err := json.Marshal(scheduledAction)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(scheduledAction.GetVersion()))
+
+	var res *http.Response
+
+	if scheduledAction.IsNew() {
+		res, err = s.client.Post(ctx, s.basePath, nil, headers, bytes.NewReader(bytesArray))
+	} else {
+		res, err = s.client.Put(ctx, fmt.Sprintf("%s/%s", s.basePath, scheduledAction.Sys.ID), nil, headers, bytes.NewReader(bytesArray))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return scheduledAction.Decode(res.Body)
+}
+
+func (s *scheduledActionsService) Cancel(ctx context.Context, scheduledAction *model.ScheduledAction) error {
+	res, err := s.client.Delete(ctx, fmt.Sprintf("%s/%s", s.basePath, scheduledAction.Sys.ID), s.environmentQuery(), make(http.Header))
+
+	if err != nil {
+		return err
+	}
+
+	return scheduledAction.Decode(res.Body)
+}
+
+func (s *scheduledActionsService) Conflicts(ctx context.Context, scheduledAction *model.ScheduledAction, window time.Duration) ([]*model.ScheduledAction, error) {
+	existing, err := cma2.CollectAll(s.List(ctx, &cma.ScheduledActionsFilter{
+		EntityIds: []string{scheduledAction.Entity.Sys.ID},
+		Status:    []model.ScheduledActionStatus{model.ScheduledActionStatusScheduled},
+	}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return model.FindScheduledActionConflicts(scheduledAction, existing, window), nil
+}
+
+func NewScheduledActionsService(client common.RestClient, environment string) cma.ScheduledActions {
+	return &scheduledActionsService{
+		client:      client,
+		environment: environment,
+		basePath:    "/scheduled_actions",
+	}
+}
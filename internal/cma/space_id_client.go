@@ -11,6 +11,13 @@ import (
 	"github.com/labd/contentful-go/internal/cma/environment_aliases"
 	"github.com/labd/contentful-go/internal/cma/environments"
 	"github.com/labd/contentful-go/internal/cma/preview_api_keys"
+	"github.com/labd/contentful-go/internal/cma/roles"
+	"github.com/labd/contentful-go/internal/cma/space_memberships"
+	"github.com/labd/contentful-go/internal/cma/team_space_memberships"
+	"github.com/labd/contentful-go/internal/cma/uploads"
+	"github.com/labd/contentful-go/internal/cma/webhook_calls"
+	"github.com/labd/contentful-go/internal/cma/webhook_signing_secret"
+	"github.com/labd/contentful-go/internal/cma/webhooks"
 	"github.com/labd/contentful-go/service/cma"
 )
 
@@ -48,6 +55,7 @@ func (c *SpaceIdClient) Delete(ctx context.Context, path string, queryParams url
 func (c *SpaceIdClient) WithEnvironment(environment string) cma.EnvironmentClient {
 	return &EnvironmentClient{
 		client:      c,
+		uploads:     c.Uploads(),
 		environment: environment,
 	}
 }
@@ -59,3 +67,34 @@ func (c *SpaceIdClient) ApiKeys() cma.ApiKeys {
 func (c *SpaceIdClient) PreviewApiKeys() cma.PreviewApiKeys {
 	return preview_api_keys.NewPreviewApiKeysService(c)
 }
+
+func (c *SpaceIdClient) Uploads() cma.Uploads {
+	return uploads.NewUploadsService(&UploadClient{
+		client:  c.client.uploadClient,
+		spaceId: c.spaceId,
+	})
+}
+
+func (c *SpaceIdClient) WebhookSigningSecret() cma.WebhookSigningSecret {
+	return webhook_signing_secret.NewWebhookSigningSecretService(c)
+}
+
+func (c *SpaceIdClient) Webhooks() cma.Webhooks {
+	return webhooks.NewWebhooksService(c)
+}
+
+func (c *SpaceIdClient) WebhookCalls() cma.WebhookCalls {
+	return webhook_calls.NewWebhookCallsService(c)
+}
+
+func (c *SpaceIdClient) Roles() cma.Roles {
+	return roles.NewRolesService(c)
+}
+
+func (c *SpaceIdClient) SpaceMemberships() cma.SpaceMemberships {
+	return space_memberships.NewSpaceMembershipsService(c)
+}
+
+func (c *SpaceIdClient) TeamSpaceMemberships() cma.TeamSpaceMemberships {
+	return team_space_memberships.NewTeamSpaceMembershipsService(c)
+}
@@ -0,0 +1,89 @@
+package space_memberships
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.SpaceMemberships = &spaceMembershipsService{}
+
+type spaceMembershipsService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (s *spaceMembershipsService) Get(ctx context.Context, membershipId string) (*model.SpaceMembership, error) {
+	res, err := s.client.Get(ctx, fmt.Sprintf("%s/%s", s.basePath, membershipId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var membership model.SpaceMembership
+
+	err = membership.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &membership, nil
+}
+
+func (s *spaceMembershipsService) List(ctx context.Context) cma.NextableCollection[*model.SpaceMembership, any] {
+	return cma2.NewCollection[*model.SpaceMembership, any](&cma2.CollectionOptions{
+		Path:   s.basePath,
+		Client: s.client,
+		Ctx:    ctx,
+	})
+}
+
+// Upsert updates or creates a new space membership
+func (s *spaceMembershipsService) Upsert(ctx context.Context, membership *model.SpaceMembership) error {
+	bytesArray, err := json.Marshal(membership)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(membership.GetVersion()))
+
+	var res *http.Response
+
+	if membership.IsNew() {
+		res, err = s.client.Post(ctx, s.basePath, nil, headers, bytes.NewReader(bytesArray))
+	} else {
+		res, err = s.client.Put(ctx, fmt.Sprintf("%s/%s", s.basePath, membership.Sys.ID), nil, headers, bytes.NewReader(bytesArray))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return membership.Decode(res.Body)
+}
+
+func (s *spaceMembershipsService) Delete(ctx context.Context, membership *model.SpaceMembership) error {
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(membership.GetVersion()))
+
+	_, err := s.client.Delete(ctx, fmt.Sprintf("%s/%s", s.basePath, membership.Sys.ID), nil, headers)
+
+	return err
+}
+
+func NewSpaceMembershipsService(client common.RestClient) cma.SpaceMemberships {
+	return &spaceMembershipsService{
+		client:   client,
+		basePath: "/space_memberships",
+	}
+}
@@ -0,0 +1,111 @@
+package spaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.Spaces = &spacesService{}
+
+type spacesService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (s *spacesService) Get(ctx context.Context, spaceId string) (*model.Space, error) {
+	res, err := s.client.Get(ctx, fmt.Sprintf("%s/%s", s.basePath, spaceId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var space model.Space
+
+	err = space.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &space, nil
+}
+
+func (s *spacesService) List(ctx context.Context) cma.NextableCollection[*model.Space, any] {
+	return cma2.NewCollection[*model.Space, any](&cma2.CollectionOptions{
+		Path:   s.basePath,
+		Client: s.client,
+		Ctx:    ctx,
+	})
+}
+
+// ListByOrganization filters the spaces by organization, since the API lists the
+// spaces of all organizations
+func (s *spacesService) ListByOrganization(ctx context.Context, organizationId string) ([]*model.Space, error) {
+	spaces, err := cma2.CollectAll(s.List(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*model.Space
+	for _, space := range spaces {
+		if space.OrganizationId() == organizationId {
+			result = append(result, space)
+		}
+	}
+
+	return result, nil
+}
+
+// Upsert updates or creates a new space
+func (s *spacesService) Upsert(ctx context.Context, space *model.Space) error {
+	bytesArray, err := json.Marshal(space)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+
+	var res *http.Response
+
+	if space.IsNew() {
+		if organizationId := space.OrganizationId(); organizationId != "" {
+			headers.Set("X-Contentful-Organization", organizationId)
+		}
+
+		res, err = s.client.Post(ctx, s.basePath, nil, headers, bytes.NewReader(bytesArray))
+	} else {
+		headers.Set("X-Contentful-Version", strconv.Itoa(space.GetVersion()))
+
+		res, err = s.client.Put(ctx, fmt.Sprintf("%s/%s", s.basePath, space.Sys.ID), nil, headers, bytes.NewReader(bytesArray))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return space.Decode(res.Body)
+}
+
+func (s *spacesService) Delete(ctx context.Context, space *model.Space) error {
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(space.GetVersion()))
+
+	_, err := s.client.Delete(ctx, fmt.Sprintf("%s/%s", s.basePath, space.Sys.ID), nil, headers)
+
+	return err
+}
+
+func NewSpacesService(client common.RestClient) cma.Spaces {
+	return &spacesService{
+		client:   client,
+		basePath: "/spaces",
+	}
+}
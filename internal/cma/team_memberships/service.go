@@ -0,0 +1,72 @@
+package team_memberships
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.TeamMemberships = &teamMembershipsService{}
+
+type teamMembershipsService struct {
+	client common.RestClient
+}
+
+func (t *teamMembershipsService) List(ctx context.Context, teamId string) cma.NextableCollection[*model.TeamMembership, any] {
+	return cma2.NewCollection[*model.TeamMembership, any](&cma2.CollectionOptions{
+		Path:   fmt.Sprintf("/teams/%s/team_memberships", teamId),
+		Client: t.client,
+		Ctx:    ctx,
+	})
+}
+
+func (t *teamMembershipsService) ListAll(ctx context.Context) cma.NextableCollection[*model.TeamMembership, any] {
+	return cma2.NewCollection[*model.TeamMembership, any](&cma2.CollectionOptions{
+		Path:   "/team_memberships",
+		Client: t.client,
+		Ctx:    ctx,
+	})
+}
+
+func (t *teamMembershipsService) Create(ctx context.Context, teamId string, membership *model.TeamMembership) error {
+	if membership.OrganizationMembershipId == "" {
+		return errors.New("team membership needs the id of an organization membership")
+	}
+
+	bytesArray, err := json.Marshal(membership)
+	if err != nil {
+		return err
+	}
+
+	res, err := t.client.Post(ctx, fmt.Sprintf("/teams/%s/team_memberships", teamId), nil, make(http.Header), bytes.NewReader(bytesArray))
+
+	if err != nil {
+		return err
+	}
+
+	return membership.Decode(res.Body)
+}
+
+func (t *teamMembershipsService) Delete(ctx context.Context, membership *model.TeamMembership) error {
+	if membership.Sys == nil || membership.Sys.Team == nil {
+		return errors.New("team membership has no team")
+	}
+
+	_, err := t.client.Delete(ctx, fmt.Sprintf("/teams/%s/team_memberships/%s", membership.Sys.Team.Sys.ID, membership.Sys.ID), nil, make(http.Header))
+
+	return err
+}
+
+func NewTeamMembershipsService(client common.RestClient) cma.TeamMemberships {
+	return &teamMembershipsService{
+		client: client,
+	}
+}
@@ -0,0 +1,91 @@
+package team_space_memberships
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.TeamSpaceMemberships = &teamSpaceMembershipsService{}
+
+type teamSpaceMembershipsService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (t *teamSpaceMembershipsService) Get(ctx context.Context, membershipId string) (*model.TeamSpaceMembership, error) {
+	res, err := t.client.Get(ctx, fmt.Sprintf("%s/%s", t.basePath, membershipId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var membership model.TeamSpaceMembership
+
+	err = membership.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &membership, nil
+}
+
+func (t *teamSpaceMembershipsService) List(ctx context.Context) cma.NextableCollection[*model.TeamSpaceMembership, any] {
+	return cma2.NewCollection[*model.TeamSpaceMembership, any](&cma2.CollectionOptions{
+		Path:   t.basePath,
+		Client: t.client,
+		Ctx:    ctx,
+	})
+}
+
+// Upsert updates or creates a new team space membership, the team of a new membership
+// is sent as header
+func (t *teamSpaceMembershipsService) Upsert(ctx context.Context, membership *model.TeamSpaceMembership) error {
+	bytesArray, err := json.Marshal(membership)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(membership.GetVersion()))
+
+	var res *http.Response
+
+	if membership.IsNew() {
+		headers.Set("X-Contentful-Team", membership.TeamId())
+		res, err = t.client.Post(ctx, t.basePath, nil, headers, bytes.NewReader(bytesArray))
+	} else {
+		res, err = t.client.Put(ctx, fmt.Sprintf("%s/%s", t.basePath, membership.Sys.ID), nil, headers, bytes.NewReader(bytesArray))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return membership.Decode(res.Body)
+}
+
+func (t *teamSpaceMembershipsService) Delete(ctx context.Context, membership *model.TeamSpaceMembership) error {
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(membership.GetVersion()))
+
+	_, err := t.client.Delete(ctx, fmt.Sprintf("%s/%s", t.basePath, membership.Sys.ID), nil, headers)
+
+	return err
+}
+
+func NewTeamSpaceMembershipsService(client common.RestClient) cma.TeamSpaceMemberships {
+	return &teamSpaceMembershipsService{
+		client:   client,
+		basePath: "/team_space_memberships",
+	}
+}
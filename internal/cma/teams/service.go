@@ -0,0 +1,89 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.Teams = &teamsService{}
+
+type teamsService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (t *teamsService) Get(ctx context.Context, teamId string) (*model.Team, error) {
+	res, err := t.client.Get(ctx, fmt.Sprintf("%s/%s", t.basePath, teamId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var team model.Team
+
+	err = team.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (t *teamsService) List(ctx context.Context) cma.NextableCollection[*model.Team, any] {
+	return cma2.NewCollection[*model.Team, any](&cma2.CollectionOptions{
+		Path:   t.basePath,
+		Client: t.client,
+		Ctx:    ctx,
+	})
+}
+
+// Upsert updates or creates a new team
+func (t *teamsService) Upsert(ctx context.Context, team *model.Team) error {
+	bytesArray, err := json.Marshal(team)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(team.GetVersion()))
+
+	var res *http.Response
+
+	if team.IsNew() {
+		res, err = t.client.Post(ctx, t.basePath, nil, headers, bytes.NewReader(bytesArray))
+	} else {
+		res, err = t.client.Put(ctx, fmt.Sprintf("%s/%s", t.basePath, team.Sys.ID), nil, headers, bytes.NewReader(bytesArray))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return team.Decode(res.Body)
+}
+
+func (t *teamsService) Delete(ctx context.Context, team *model.Team) error {
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(team.GetVersion()))
+
+	_, err := t.client.Delete(ctx, fmt.Sprintf("%s/%s", t.basePath, team.Sys.ID), nil, headers)
+
+	return err
+}
+
+func NewTeamsService(client common.RestClient) cma.Teams {
+	return &teamsService{
+		client:   client,
+		basePath: "/teams",
+	}
+}
@@ -0,0 +1,36 @@
+package cma
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	internalcommon "github.com/labd/contentful-go/internal/common"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ common.RestClient = &UploadClient{}
+
+// UploadClient sends space scoped requests to the upload API
+type UploadClient struct {
+	client  *internalcommon.Client
+	spaceId string
+}
+
+func (c *UploadClient) Get(ctx context.Context, path string, queryParams url.Values, headers http.Header) (*http.Response, error) {
+	return c.client.Get(ctx, fmt.Sprintf("/spaces/%s%s", c.spaceId, path), queryParams, headers)
+}
+
+func (c *UploadClient) Post(ctx context.Context, path string, queryParams url.Values, headers http.Header, body io.Reader) (*http.Response, error) {
+	return c.client.Post(ctx, fmt.Sprintf("/spaces/%s%s", c.spaceId, path), queryParams, headers, body)
+}
+
+func (c *UploadClient) Put(ctx context.Context, path string, queryParams url.Values, headers http.Header, body io.Reader) (*http.Response, error) {
+	return c.client.Put(ctx, fmt.Sprintf("/spaces/%s%s", c.spaceId, path), queryParams, headers, body)
+}
+
+func (c *UploadClient) Delete(ctx context.Context, path string, queryParams url.Values, headers http.Header) (*http.Response, error) {
+	return c.client.Delete(ctx, fmt.Sprintf("/spaces/%s%s", c.spaceId, path), queryParams, headers)
+}
@@ -0,0 +1,133 @@
+package uploads
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.Uploads = &uploadsService{}
+
+type uploadsService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (u *uploadsService) Get(ctx context.Context, uploadId string) (*model.Upload, error) {
+	res, err := u.client.Get(ctx, fmt.Sprintf("%s/%s", u.basePath, uploadId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var upload model.Upload
+
+	err = upload.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
+
+func (u *uploadsService) Create(ctx context.Context, reader io.Reader, options *cma.UploadOptions) (*model.Upload, error) {
+	if options == nil {
+		options = &cma.UploadOptions{}
+	}
+
+	size := options.Size
+	if size <= 0 {
+		size = readerSize(reader)
+	}
+
+	if size > cma.MaxUploadSize {
+		return nil, fmt.Errorf("upload of %d bytes exceeds the maximum of %d bytes", size, cma.MaxUploadSize)
+	}
+
+	headers := make(http.Header)
+
+	if size > 0 {
+		headers.Set("Content-Length", strconv.FormatInt(size, 10))
+	}
+
+	if options.Progress != nil {
+		reader = &progressReader{
+			reader:   reader,
+			total:    size,
+			progress: options.Progress,
+		}
+	}
+
+	res, err := u.client.Post(ctx, u.basePath, nil, headers, reader)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var upload model.Upload
+
+	err = upload.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
+
+func (u *uploadsService) Delete(ctx context.Context, upload *model.Upload) error {
+	_, err := u.client.Delete(ctx, fmt.Sprintf("%s/%s", u.basePath, upload.Sys.ID), nil, make(http.Header))
+
+	return err
+}
+
+// readerSize returns the remaining size of readers which know it, and 0 otherwise
+func readerSize(reader io.Reader) int64 {
+	switch typed := reader.(type) {
+	case interface{ Len() int }:
+		return int64(typed.Len())
+	case *os.File:
+		info, err := typed.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0
+		}
+
+		offset, err := typed.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0
+		}
+
+		return info.Size() - offset
+	}
+
+	return 0
+}
+
+type progressReader struct {
+	reader   io.Reader
+	written  int64
+	total    int64
+	progress func(written int64, total int64)
+}
+
+func (p *progressReader) Read(buffer []byte) (int, error) {
+	n, err := p.reader.Read(buffer)
+
+	if n > 0 {
+		p.written += int64(n)
+		p.progress(p.written, p.total)
+	}
+
+	return n, err
+}
+
+func NewUploadsService(client common.RestClient) cma.Uploads {
+	return &uploadsService{
+		client:   client,
+		basePath: "/uploads",
+	}
+}
@@ -0,0 +1,49 @@
+package users
+
+import (
+	"context"
+	"fmt"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.Users = &usersService{}
+
+type usersService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (u *usersService) Get(ctx context.Context, userId string) (*model.User, error) {
+	res, err := u.client.Get(ctx, fmt.Sprintf("%s/%s", u.basePath, userId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var user model.User
+
+	err = user.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (u *usersService) List(ctx context.Context) cma.NextableCollection[*model.User, any] {
+	return cma2.NewCollection[*model.User, any](&cma2.CollectionOptions{
+		Path:   u.basePath,
+		Client: u.client,
+		Ctx:    ctx,
+	})
+}
+
+func NewUsersService(client common.RestClient) cma.Users {
+	return &usersService{
+		client:   client,
+		basePath: "/users",
+	}
+}
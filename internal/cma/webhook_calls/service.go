@@ -0,0 +1,65 @@
+package webhook_calls
+
+import (
+	"context"
+	"fmt"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.WebhookCalls = &webhookCallsService{}
+
+type webhookCallsService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (w *webhookCallsService) Get(ctx context.Context, webhookId string, callId string) (*model.WebhookCall, error) {
+	res, err := w.client.Get(ctx, fmt.Sprintf("%s/%s/calls/%s", w.basePath, webhookId, callId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var call model.WebhookCall
+
+	err = call.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &call, nil
+}
+
+func (w *webhookCallsService) List(ctx context.Context, webhookId string) cma.NextableCollection[*model.WebhookCall, any] {
+	return cma2.NewCollection[*model.WebhookCall, any](&cma2.CollectionOptions{
+		Path:   fmt.Sprintf("%s/%s/calls", w.basePath, webhookId),
+		Client: w.client,
+		Ctx:    ctx,
+	})
+}
+
+func (w *webhookCallsService) Health(ctx context.Context, webhookId string) (*model.WebhookHealth, error) {
+	res, err := w.client.Get(ctx, fmt.Sprintf("%s/%s/health", w.basePath, webhookId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var health model.WebhookHealth
+
+	err = health.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &health, nil
+}
+
+func NewWebhookCallsService(client common.RestClient) cma.WebhookCalls {
+	return &webhookCallsService{
+		client:   client,
+		basePath: "/webhooks",
+	}
+}
@@ -0,0 +1,91 @@
+package webhook_signing_secret
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/pkgs/webhook"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.WebhookSigningSecret = &webhookSigningSecretService{}
+
+type webhookSigningSecretService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (w *webhookSigningSecretService) Get(ctx context.Context) (*model.WebhookSigningSecret, error) {
+	res, err := w.client.Get(ctx, w.basePath, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var secret model.WebhookSigningSecret
+
+	err = secret.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &secret, nil
+}
+
+func (w *webhookSigningSecretService) Create(ctx context.Context, value string) (*model.WebhookSigningSecret, error) {
+	if value == "" {
+		return w.Rotate(ctx)
+	}
+
+	err := webhook.ValidateSecret(value)
+	if err != nil {
+		return nil, err
+	}
+
+	bytesArray, err := json.Marshal(&model.WebhookSigningSecret{Value: value})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := w.client.Put(ctx, w.basePath, nil, make(http.Header), bytes.NewReader(bytesArray))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var secret model.WebhookSigningSecret
+
+	err = secret.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the response is redacted, the caller needs the value to verify requests
+	secret.Value = value
+	return &secret, nil
+}
+
+func (w *webhookSigningSecretService) Rotate(ctx context.Context) (*model.WebhookSigningSecret, error) {
+	value, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return w.Create(ctx, value)
+}
+
+func (w *webhookSigningSecretService) Delete(ctx context.Context) error {
+	_, err := w.client.Delete(ctx, w.basePath, nil, make(http.Header))
+
+	return err
+}
+
+func NewWebhookSigningSecretService(client common.RestClient) cma.WebhookSigningSecret {
+	return &webhookSigningSecretService{
+		client:   client,
+		basePath: "/webhook_settings/signing_secret",
+	}
+}
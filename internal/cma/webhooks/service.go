@@ -0,0 +1,89 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/common"
+)
+
+var _ cma.Webhooks = &webhooksService{}
+
+type webhooksService struct {
+	client   common.RestClient
+	basePath string
+}
+
+func (w *webhooksService) Get(ctx context.Context, webhookId string) (*model.Webhook, error) {
+	res, err := w.client.Get(ctx, fmt.Sprintf("%s/%s", w.basePath, webhookId), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook model.Webhook
+
+	err = webhook.Decode(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (w *webhooksService) List(ctx context.Context) cma.NextableCollection[*model.Webhook, any] {
+	return cma2.NewCollection[*model.Webhook, any](&cma2.CollectionOptions{
+		Path:   w.basePath,
+		Client: w.client,
+		Ctx:    ctx,
+	})
+}
+
+// Upsert updates or creates a new webhook entity
+func (w *webhooksService) Upsert(ctx context.Context, webhook *model.Webhook) error {
+	bytesArray, err := json.Marshal(webhook)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(webhook.GetVersion()))
+
+	var res *http.Response
+
+	if webhook.IsNew() {
+		res, err = w.client.Post(ctx, w.basePath, nil, headers, bytes.NewReader(bytesArray))
+	} else {
+		res, err = w.client.Put(ctx, fmt.Sprintf("%s/%s", w.basePath, webhook.Sys.ID), nil, headers, bytes.NewReader(bytesArray))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return webhook.Decode(res.Body)
+}
+
+func (w *webhooksService) Delete(ctx context.Context, webhook *model.Webhook) error {
+	headers := make(http.Header)
+
+	headers.Set("X-Contentful-Version", strconv.Itoa(webhook.GetVersion()))
+
+	_, err := w.client.Delete(ctx, fmt.Sprintf("%s/%s", w.basePath, webhook.Sys.ID), nil, headers)
+
+	return err
+}
+
+func NewWebhooksService(client common.RestClient) cma.Webhooks {
+	return &webhooksService{
+		client:   client,
+		basePath: "/webhook_definitions",
+	}
+}
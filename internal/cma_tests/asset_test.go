@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/labd/contentful-go/internal/testutil"
 	"github.com/labd/contentful-go/pkgs/common"
 	"github.com/labd/contentful-go/pkgs/model"
+	cmaservice "github.com/labd/contentful-go/service/cma"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -297,3 +300,114 @@ func TestAssetService_Unarchive(t *testing.T) {
 	err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("testing").Assets().Unarchive(context.Background(), asset)
 	assertions.Nil(err)
 }
+
+func TestAssetService_CreateFromReader(t *testing.T) {
+	assertions := assert.New(t)
+
+	prefix := "/spaces/" + testutil.SpaceID + "/environments/testing/assets"
+	var requests []string
+	polls := 0
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /spaces/" + testutil.SpaceID + "/uploads":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(testutil.ReadTestData("upload/get.json")))
+		case "POST " + prefix:
+			var payload map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&payload)
+			assertions.Nil(err)
+			file := payload["fields"].(map[string]interface{})["file"].(map[string]interface{})["de"].(map[string]interface{})
+			assertions.Equal("doge.png", file["fileName"])
+			assertions.Equal("2DNvIbYNELgqLJUkgTeIOV", file["uploadFrom"].(map[string]interface{})["sys"].(map[string]interface{})["id"])
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(testutil.ReadTestData("asset/unprocessed.json")))
+		case "GET " + prefix + "/3HNzx9gvJScKku4UmcekYw":
+			polls++
+			if polls == 1 {
+				_, _ = w.Write([]byte(testutil.ReadTestData("asset/unprocessed.json")))
+				return
+			}
+			_, _ = w.Write([]byte(testutil.ReadTestData("asset/get.json")))
+		case "PUT " + prefix + "/3HNzx9gvJScKku4UmcekYw/published":
+			assertions.Equal("9", r.Header.Get("X-Contentful-Version"))
+			_, _ = w.Write([]byte(testutil.ReadTestData("asset/update.json")))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}, func(r *http.Request) {})
+
+	defer ts.Close()
+
+	asset, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("testing").Assets().CreateFromReader(context.Background(), strings.NewReader("image"), &cmaservice.AssetMetadata{
+		Title:       map[string]string{"en-US": "hehehe", "de": "hehehe-de"},
+		FileName:    "doge.png",
+		ContentType: "image/png",
+		Locales:     []string{"en-US", "de"},
+	}, &cmaservice.CreateAssetOptions{
+		PollInterval: time.Millisecond,
+		Publish:      true,
+	})
+	assertions.Nil(err)
+	assertions.Equal("3HNzx9gvJScKku4UmcekYw", asset.Sys.ID)
+	assertions.NotEmpty(asset.Fields.File["en-US"].URL)
+	assertions.Len(requests, 7)
+	assertions.Equal([]string{
+		"POST /spaces/" + testutil.SpaceID + "/uploads",
+		"POST " + prefix,
+	}, requests[:2])
+	// locales are processed in no particular order
+	assertions.ElementsMatch([]string{
+		"PUT " + prefix + "/3HNzx9gvJScKku4UmcekYw/files/de/process",
+		"PUT " + prefix + "/3HNzx9gvJScKku4UmcekYw/files/en-US/process",
+	}, requests[2:4])
+	assertions.Equal([]string{
+		"GET " + prefix + "/3HNzx9gvJScKku4UmcekYw",
+		"GET " + prefix + "/3HNzx9gvJScKku4UmcekYw",
+		"PUT " + prefix + "/3HNzx9gvJScKku4UmcekYw/published",
+	}, requests[4:])
+}
+
+func TestAssetService_CreateFromReader_Timeout(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/uploads"):
+			_, _ = w.Write([]byte(testutil.ReadTestData("upload/get.json")))
+		case strings.HasSuffix(r.URL.Path, "/process"):
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			_, _ = w.Write([]byte(testutil.ReadTestData("asset/unprocessed.json")))
+		}
+	}, func(r *http.Request) {})
+
+	defer ts.Close()
+
+	_, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("testing").Assets().CreateFromReader(context.Background(), strings.NewReader("image"), &cmaservice.AssetMetadata{
+		Title:    map[string]string{"en-US": "hehehe"},
+		FileName: "doge.png",
+	}, &cmaservice.CreateAssetOptions{
+		ProcessingTimeout: 20 * time.Millisecond,
+		PollInterval:      time.Millisecond,
+		MaxPollInterval:   2 * time.Millisecond,
+	})
+	assertions.True(errors.Is(err, cmaservice.ErrProcessingTimeout))
+}
+
+func TestAssetService_CreateFromReader_NoMetadata(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected without metadata")
+	}, func(r *http.Request) {})
+
+	defer ts.Close()
+
+	asset, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("testing").Assets().CreateFromReader(context.Background(), strings.NewReader("image"), nil, nil)
+	assertions.NotNil(err)
+	assertions.Nil(asset)
+}
@@ -0,0 +1,218 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordedRequest struct {
+	Method  string
+	Path    string
+	LinksTo string
+	Body    map[string]any
+}
+
+func cascadeHandler(requests *[]recordedRequest, linksFixture string, entityFixture string) testutil.HTTPHandler {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := recordedRequest{
+			Method:  r.Method,
+			Path:    r.URL.Path,
+			LinksTo: r.URL.Query().Get("links_to_entry") + r.URL.Query().Get("links_to_asset"),
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if len(body) > 0 {
+			_ = json.Unmarshal(body, &request.Body)
+		}
+
+		*requests = append(*requests, request)
+
+		switch {
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(testutil.ReadTestData(linksFixture)))
+		case r.Method == http.MethodPut && len(body) > 0:
+			_, _ = w.Write(body)
+		case r.Method == http.MethodDelete && (r.URL.Path == "/spaces/"+testutil.SpaceID+"/environments/test/entries/nyancat" || r.URL.Path == "/spaces/"+testutil.SpaceID+"/environments/test/assets/3HNzx9gvJScKku4UmcekYw"):
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			_, _ = w.Write([]byte(testutil.ReadTestData(entityFixture)))
+		}
+	}
+}
+
+func TestEntryService_Cascade_RemoveReferences(t *testing.T) {
+	assertions := assert.New(t)
+	var requests []recordedRequest
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, cascadeHandler(&requests, "/entry/links_to_published.json", "/entry/published.json"), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	var entry *model.Entry
+	err := testutil.ModelFromTestData("/entry/published.json", &entry)
+	assertions.Nil(err)
+
+	report, err := client.WithSpaceId(testutil.SpaceID).WithEnvironment("test").Entries().Cascade(context.Background(), entry, cma.CascadeOptions{
+		Action:     cma.CascadeDelete,
+		References: cma.ReferencesRemove,
+	})
+	assertions.Nil(err)
+
+	prefix := "/spaces/" + testutil.SpaceID + "/environments/test/entries"
+	assertions.Len(requests, 6)
+	assertions.Equal(recordedRequest{Method: "GET", Path: prefix, LinksTo: "nyancat"}, requests[0])
+	assertions.Equal("PUT", requests[1].Method)
+	assertions.Equal(prefix+"/a", requests[1].Path)
+	assertions.NotContains(requests[1].Body["fields"], "bestFriend")
+	assertions.Equal("PUT", requests[2].Method)
+	assertions.Equal(prefix+"/a/published", requests[2].Path)
+	assertions.Equal(prefix+"/b", requests[3].Path)
+	assertions.Equal([]any{}, requests[3].Body["fields"].(map[string]any)["friends"].(map[string]any)["en-US"])
+	assertions.Equal(recordedRequest{Method: "DELETE", Path: prefix + "/nyancat/published"}, requests[4])
+	assertions.Equal(recordedRequest{Method: "DELETE", Path: prefix + "/nyancat"}, requests[5])
+
+	assertions.False(report.DryRun)
+	assertions.True(report.Unpublished)
+	assertions.True(report.Deleted)
+	assertions.False(report.Archived)
+	assertions.Len(report.References, 2)
+	assertions.True(report.References[0].Removed)
+	assertions.True(report.References[0].Republished)
+	assertions.True(report.References[1].Removed)
+	assertions.False(report.References[1].Republished)
+}
+
+func TestEntryService_Cascade_DryRun(t *testing.T) {
+	assertions := assert.New(t)
+	var requests []recordedRequest
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, cascadeHandler(&requests, "/entry/links_to_published.json", "/entry/published.json"), func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+	})
+
+	defer ts.Close()
+
+	var entry *model.Entry
+	err := testutil.ModelFromTestData("/entry/published.json", &entry)
+	assertions.Nil(err)
+
+	report, err := client.WithSpaceId(testutil.SpaceID).WithEnvironment("test").Entries().Cascade(context.Background(), entry, cma.CascadeOptions{
+		Action:     cma.CascadeArchive,
+		References: cma.ReferencesRemove,
+		DryRun:     true,
+	})
+	assertions.Nil(err)
+	assertions.Len(requests, 1)
+	assertions.True(report.DryRun)
+	assertions.True(report.Unpublished)
+	assertions.True(report.Archived)
+	assertions.Len(report.References, 2)
+	assertions.Equal([]model.FieldLink{{Field: "bestFriend", Locale: "en-US", LinkType: "Entry", ID: "nyancat"}}, report.References[0].Links)
+	assertions.True(report.References[0].Entry.LinksTo(model.LinkTypeEntry, "nyancat"))
+}
+
+func TestEntryService_Cascade_MultiplePages(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("skip") == "2" {
+			_, _ = w.Write([]byte(testutil.ReadTestData("/entry/links_to_page_2.json")))
+			return
+		}
+
+		_, _ = w.Write([]byte(testutil.ReadTestData("/entry/links_to_page_1.json")))
+	}, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("nyancat", r.URL.Query().Get("links_to_entry"))
+	})
+
+	defer ts.Close()
+
+	var entry *model.Entry
+	err := testutil.ModelFromTestData("/entry/published.json", &entry)
+	assertions.Nil(err)
+
+	report, err := client.WithSpaceId(testutil.SpaceID).WithEnvironment("test").Entries().Cascade(context.Background(), entry, cma.CascadeOptions{
+		Action:     cma.CascadeDelete,
+		References: cma.ReferencesReport,
+		DryRun:     true,
+	})
+	assertions.Nil(err)
+
+	ids := make([]string, 0, len(report.References))
+	for _, reference := range report.References {
+		ids = append(ids, reference.Entry.Sys.ID)
+		assertions.NotEmpty(reference.Links)
+	}
+
+	assertions.Equal([]string{"a", "b", "c"}, ids)
+}
+
+func TestCascadeAction_String(t *testing.T) {
+	assertions := assert.New(t)
+
+	assertions.Equal("archive", cma.CascadeArchive.String())
+	assertions.Equal("delete", cma.CascadeDelete.String())
+	assertions.Equal("CascadeAction(5)", cma.CascadeAction(5).String())
+}
+
+func TestEntryService_Cascade_Abort(t *testing.T) {
+	assertions := assert.New(t)
+	var requests []recordedRequest
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, cascadeHandler(&requests, "/entry/links_to_published.json", "/entry/published.json"), func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+	})
+
+	defer ts.Close()
+
+	var entry *model.Entry
+	err := testutil.ModelFromTestData("/entry/published.json", &entry)
+	assertions.Nil(err)
+
+	report, err := client.WithSpaceId(testutil.SpaceID).WithEnvironment("test").Entries().Cascade(context.Background(), entry, cma.CascadeOptions{
+		Action: cma.CascadeDelete,
+	})
+	assertions.True(errors.Is(err, cma.ErrEntityReferenced))
+	assertions.Len(report.References, 2)
+	assertions.False(report.Deleted)
+}
+
+func TestAssetService_Cascade_ReportReferences(t *testing.T) {
+	assertions := assert.New(t)
+	var requests []recordedRequest
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, cascadeHandler(&requests, "/entry/list.json", "/asset/get.json"), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	var asset *model.Asset
+	err := testutil.ModelFromTestData("/asset/get.json", &asset)
+	assertions.Nil(err)
+
+	report, err := client.WithSpaceId(testutil.SpaceID).WithEnvironment("test").Assets().Cascade(context.Background(), asset, cma.CascadeOptions{
+		Action:     cma.CascadeDelete,
+		References: cma.ReferencesReport,
+	})
+	assertions.Nil(err)
+
+	assertions.Len(requests, 3)
+	assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/test/entries", requests[0].Path)
+	assertions.Equal("3HNzx9gvJScKku4UmcekYw", requests[0].LinksTo)
+	assertions.Equal(recordedRequest{Method: "DELETE", Path: "/spaces/" + testutil.SpaceID + "/environments/test/assets/3HNzx9gvJScKku4UmcekYw/published"}, requests[1])
+	assertions.Equal(recordedRequest{Method: "DELETE", Path: "/spaces/" + testutil.SpaceID + "/environments/test/assets/3HNzx9gvJScKku4UmcekYw"}, requests[2])
+	assertions.Len(report.References, 1)
+	assertions.False(report.References[0].Removed)
+	assertions.True(report.Unpublished)
+	assertions.True(report.Deleted)
+}
@@ -0,0 +1,120 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditorInterfaceService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/editor_interface/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/content_types/blogPost/editor_interface", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	editorInterface, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").EditorInterfaces().Get(context.Background(), "blogPost")
+	assertions.Nil(err)
+	assertions.Equal("blogPost", editorInterface.ContentTypeId())
+
+	body := editorInterface.Control("body")
+	assertions.Equal(model.WidgetNamespaceApp, body.WidgetNamespace)
+	assertions.Equal([]any{"bold", "italic", "link"}, body.Settings["toolbar"])
+	assertions.Equal(map[string]any{"enabled": true, "theme": "dark"}, body.Settings["preview"])
+
+	assertions.True(editorInterface.Sidebar[2].Disabled)
+	assertions.Len(editorInterface.Editors, 2)
+
+	content := editorInterface.EditorLayout[0]
+	assertions.True(content.IsGroup())
+	assertions.Equal("meta", content.Items[2].GroupID)
+	assertions.Equal("slug", content.Items[2].Items[0].FieldID)
+	assertions.Equal(true, editorInterface.GroupControl("meta").Settings["collapsedByDefault"])
+}
+
+func TestEditorInterfaceService_RoundTrip(t *testing.T) {
+	// an empty sidebar hides the default sidebar, and widgets may omit disabled
+	for _, fileName := range []string{"editor_interface/get.json", "editor_interface/empty_sidebar.json"} {
+		t.Run(fileName, func(t *testing.T) {
+			assertions := assert.New(t)
+
+			var editorInterface *model.EditorInterface
+			err := testutil.ModelFromTestData("/"+fileName, &editorInterface)
+			assertions.Nil(err)
+
+			data, err := json.Marshal(editorInterface)
+			assertions.Nil(err)
+			assertions.JSONEq(testutil.ReadTestData(fileName), string(data))
+		})
+	}
+}
+
+func TestEditorInterfaceService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/editor_interface.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/editor_interfaces", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	collection, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").EditorInterfaces().List(context.Background()).Next()
+	assertions.Nil(err)
+	assertions.Len(collection.Items, 1)
+	assertions.Equal("hfM9RCJIk0wIm06WkEOQY", collection.Items[0].ContentTypeId())
+	assertions.Equal("someuiextension", collection.Items[0].Sidebar[0].WidgetID)
+}
+
+func TestEditorInterfaceService_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/editor_interface/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/content_types/blogPost/editor_interface", r.URL.Path)
+		assertions.Equal("7", r.Header.Get("X-Contentful-Version"))
+
+		body, err := io.ReadAll(r.Body)
+		assertions.Nil(err)
+
+		var payload struct {
+			Controls     []map[string]any `json:"controls"`
+			EditorLayout []map[string]any `json:"editorLayout"`
+		}
+		err = json.Unmarshal(body, &payload)
+		assertions.Nil(err)
+
+		assertions.Equal(map[string]any{
+			"fieldId":         "author",
+			"widgetNamespace": "builtin",
+			"widgetId":        "entryCardEditor",
+			"settings":        map[string]any{"showCreateEntityAction": false},
+		}, payload.Controls[4])
+		assertions.Equal(map[string]any{"groupId": "settings", "name": "Settings", "items": []any{map[string]any{"fieldId": "author"}}}, payload.EditorLayout[3])
+	})
+
+	defer ts.Close()
+
+	var editorInterface *model.EditorInterface
+	err := testutil.ModelFromTestData("/editor_interface/get.json", &editorInterface)
+	assertions.Nil(err)
+
+	editorInterface.SetControl("author", model.WidgetNamespaceBuiltin, "entryCardEditor", model.WidgetSettings{"showCreateEntityAction": false})
+	editorInterface.EditorLayout = append(editorInterface.EditorLayout, model.NewEditorLayoutGroup("settings", "Settings", model.NewEditorLayoutField("author")))
+
+	err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").EditorInterfaces().Update(context.Background(), editorInterface)
+	assertions.Nil(err)
+
+	err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").EditorInterfaces().Update(context.Background(), &model.EditorInterface{})
+	assertions.NotNil(err)
+}
@@ -235,3 +235,96 @@ func TestEntryService_Unarchive(t *testing.T) {
 	err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("test").Entries().Unarchive(context.Background(), entry)
 	assertions.Nil(err)
 }
+
+func TestEntryService_References(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/entry/references.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/test/entries/nyancat/references", r.URL.Path)
+		assertions.Equal("2", r.URL.Query().Get("include"))
+	})
+
+	defer ts.Close()
+
+	graph, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("test").Entries().References(context.Background(), "nyancat", 2)
+	assertions.Nil(err)
+	assertions.Equal("nyancat", graph.Root.Sys.ID)
+	assertions.Len(graph.Entries, 2)
+	assertions.Len(graph.Assets, 2)
+
+	assertions.Equal([]model.FieldLink{
+		{Field: "bestFriend", Locale: "en-US", LinkType: "Entry", ID: "happycat"},
+		{Field: "image", Locale: "de", LinkType: "Asset", ID: "nyancatimage"},
+		{Field: "image", Locale: "en-US", LinkType: "Asset", ID: "nyancatimage"},
+	}, graph.Children("nyancat"))
+
+	var visited []string
+	graph.Walk(func(parent *model.Entry, link model.FieldLink) bool {
+		visited = append(visited, parent.Sys.ID+">"+link.ID)
+		return true
+	})
+	assertions.Equal([]string{"nyancat>happycat", "nyancat>nyancatimage", "happycat>happycatimage", "happycat>grumpycat"}, visited)
+
+	assertions.Equal([]model.FieldLink{
+		{Field: "friends", Locale: "en-US", LinkType: "Entry", ID: "grumpycat"},
+	}, graph.Unresolved())
+}
+
+func TestEntryService_References_InvalidDepth(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/entry/references.json"}, nil, func(r *http.Request) {
+		assertions.Fail("no request expected")
+	})
+
+	defer ts.Close()
+
+	_, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("test").Entries().References(context.Background(), "nyancat", 11)
+	assertions.NotNil(err)
+}
+
+func TestEntryService_LinksToEntry(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("skip") == "2" {
+			_, _ = w.Write([]byte(testutil.ReadTestData("/entry/links_to_page_2.json")))
+			return
+		}
+
+		_, _ = w.Write([]byte(testutil.ReadTestData("/entry/links_to_page_1.json")))
+	}, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/test/entries", r.URL.Path)
+		assertions.Equal("nyancat", r.URL.Query().Get("links_to_entry"))
+	})
+
+	defer ts.Close()
+
+	entries, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("test").Entries().LinksToEntry(context.Background(), "nyancat")
+	assertions.Nil(err)
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.Sys.ID)
+		assertions.True(entry.LinksTo(model.LinkTypeEntry, "nyancat"))
+	}
+
+	assertions.Equal([]string{"a", "b", "c"}, ids)
+}
+
+func TestEntryService_LinksToAsset(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/entry/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/test/entries", r.URL.Path)
+		assertions.Equal("nyancatimage", r.URL.Query().Get("links_to_asset"))
+	})
+
+	defer ts.Close()
+
+	entries, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("test").Entries().LinksToAsset(context.Background(), "nyancatimage")
+	assertions.Nil(err)
+	assertions.Len(entries, 1)
+}
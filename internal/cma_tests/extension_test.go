@@ -0,0 +1,194 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtensionService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/extension/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/extensions/markdown-preview", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	extension, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Extensions().Get(context.Background(), "markdown-preview")
+	assertions.Nil(err)
+	assertions.Equal("Markdown preview", extension.Extension.Name)
+	assertions.Equal([]model.FieldType{{Type: "Text"}, {Type: "Symbol"}}, extension.Extension.FieldTypes)
+
+	parameters := extension.Extension.Parameters
+	assertions.Len(parameters.Installation, 2)
+	assertions.Equal([]string{"light", "dark"}, parameters.Installation[1].OptionValues())
+	assertions.Equal("Choose a theme", parameters.Installation[1].Labels.Empty)
+	assertions.Equal("Visible", parameters.Instance[1].Labels.True)
+	assertions.Equal(float64(5000), parameters.Instance[0].Default)
+	assertions.Nil(extension.ValidateInstallationParameters())
+}
+
+func TestExtensionService_RoundTrip(t *testing.T) {
+	assertions := assert.New(t)
+
+	var extension *model.Extension
+	err := testutil.ModelFromTestData("/extension/get.json", &extension)
+	assertions.Nil(err)
+
+	data, err := json.Marshal(extension)
+	assertions.Nil(err)
+	assertions.JSONEq(testutil.ReadTestData("extension/get.json"), string(data))
+}
+
+func TestExtensionService_Upsert_Srcdoc(t *testing.T) {
+	assertions := assert.New(t)
+
+	html := testutil.ReadTestData("extension/index.html")
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "/extension/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/extensions", r.URL.Path)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]any{
+			"name":       "Markdown editor",
+			"srcdoc":     html,
+			"fieldTypes": []any{map[string]any{"type": "Text"}},
+			"parameters": map[string]any{
+				"instance": []any{map[string]any{"id": "rows", "name": "Rows", "type": "Number"}},
+			},
+		}, payload["extension"])
+	})
+
+	defer ts.Close()
+
+	extension := &model.Extension{
+		Extension: model.ExtensionDetails{
+			Name:       "Markdown editor",
+			Src:        "https://extensions.example.com/markdown-editor",
+			FieldTypes: []model.FieldType{{Type: "Text"}},
+			Parameters: &model.ExtensionParameters{
+				Instance: []*model.ParameterDefinition{{ID: "rows", Name: "Rows", Type: model.ParameterTypeNumber}},
+			},
+		},
+	}
+
+	err := extension.LoadSrcdoc("../../testdata/extension/index.html")
+	assertions.Nil(err)
+	assertions.Empty(extension.Extension.Src)
+
+	err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Extensions().Upsert(context.Background(), extension)
+	assertions.Nil(err)
+	assertions.Equal("markdown-preview", extension.Sys.ID)
+}
+
+func TestExtensionService_Upsert_InvalidParameters(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/extension/get.json"}, nil, func(r *http.Request) {
+		t.Error("invalid extension must not be sent")
+	})
+
+	defer ts.Close()
+
+	var extension *model.Extension
+	err := testutil.ModelFromTestData("/extension/get.json", &extension)
+	assertions.Nil(err)
+
+	extension.Parameters = map[string]any{"theme": "sepia", "region": "eu"}
+
+	err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Extensions().Upsert(context.Background(), extension)
+	assertions.ErrorIs(err, model.ErrInvalidParameter)
+	assertions.ErrorContains(err, "apiKey is required")
+	assertions.ErrorContains(err, "sepia is not a valid value for theme")
+	assertions.ErrorContains(err, "region is not defined")
+}
+
+func TestExtensionService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 204}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/extensions/markdown-preview", r.URL.Path)
+		assertions.Equal("4", r.Header.Get("X-Contentful-Version"))
+	})
+
+	defer ts.Close()
+
+	var extension *model.Extension
+	err := testutil.ModelFromTestData("/extension/get.json", &extension)
+	assertions.Nil(err)
+
+	err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Extensions().Delete(context.Background(), extension)
+	assertions.Nil(err)
+}
+
+func TestExtension_LoadSrcdoc_TooLarge(t *testing.T) {
+	assertions := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "index.html")
+	err := os.WriteFile(path, []byte(strings.Repeat("a", model.ExtensionSrcdocMaxSize+1)), 0o600)
+	assertions.Nil(err)
+
+	extension := &model.Extension{Extension: model.ExtensionDetails{Src: "https://extensions.example.com"}}
+
+	err = extension.LoadSrcdoc(path)
+	assertions.ErrorIs(err, model.ErrSrcdocTooLarge)
+	assertions.Equal("https://extensions.example.com", extension.Extension.Src)
+}
+
+func TestEditorInterface_UseExtension(t *testing.T) {
+	assertions := assert.New(t)
+
+	var extension *model.Extension
+	err := testutil.ModelFromTestData("/extension/get.json", &extension)
+	assertions.Nil(err)
+
+	var editorInterface *model.EditorInterface
+	err = testutil.ModelFromTestData("/editor_interface/get.json", &editorInterface)
+	assertions.Nil(err)
+
+	control, err := editorInterface.UseExtension("body", extension, model.WidgetSettings{"toolbar": true, "mode": "split"})
+	assertions.Nil(err)
+	assertions.Same(editorInterface.Control("body"), control)
+	assertions.Equal(model.WidgetNamespaceExtension, control.WidgetNamespace)
+	assertions.Equal("markdown-preview", control.WidgetID)
+	assertions.Len(editorInterface.Controls, 5)
+
+	_, err = editorInterface.UseExtension("summary", extension, model.WidgetSettings{"mode": "inline"})
+	assertions.ErrorIs(err, model.ErrInvalidParameter)
+	assertions.ErrorContains(err, "toolbar is required")
+	assertions.ErrorContains(err, "inline is not a valid value for mode")
+	assertions.Nil(editorInterface.Control("summary"))
+
+	_, err = editorInterface.UseExtension("summary", &model.Extension{}, nil)
+	assertions.NotNil(err)
+
+	extension.Extension.Sidebar = true
+
+	_, err = editorInterface.UseExtension("summary", extension, model.WidgetSettings{"toolbar": true})
+	assertions.NotNil(err)
+
+	widget, err := editorInterface.AddSidebarExtension(extension, model.WidgetSettings{"toolbar": false})
+	assertions.Nil(err)
+	assertions.Len(editorInterface.Sidebar, 4)
+	assertions.Equal("markdown-preview", widget.WidgetID)
+
+	_, err = editorInterface.AddSidebarExtension(extension, model.WidgetSettings{"toolbar": true})
+	assertions.Nil(err)
+	assertions.Len(editorInterface.Sidebar, 4)
+	assertions.Equal(true, editorInterface.Sidebar[3].Settings["toolbar"])
+}
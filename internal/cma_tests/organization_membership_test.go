@@ -0,0 +1,264 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganizationMembershipService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/organization_membership/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/organization_memberships", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	collection, err := cma.WithOrganizationId(testutil.OrganizationId).OrganizationMemberships().List(context.Background()).Next()
+	assertions.Nil(err)
+	assertions.Len(collection.Items, 2)
+	assertions.Equal("4FLrUHftHW3v2BLi9fzfjU", collection.Items[0].UserId())
+	assertions.Equal(model.OrganizationRoleOwner, collection.Items[1].Role)
+}
+
+func TestOrganizationMembershipService_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/organization_membership/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/organization_memberships/1Pb0DM4d3MjHrsMijLYuPo", r.URL.Path)
+		assertions.Equal("3", r.Header.Get("X-Contentful-Version"))
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]any{"role": "admin"}, payload)
+	})
+
+	defer ts.Close()
+
+	var membership *model.OrganizationMembership
+	err := testutil.ModelFromTestData("/organization_membership/get.json", &membership)
+	assertions.Nil(err)
+
+	membership.Role = model.OrganizationRoleAdmin
+
+	err = cma.WithOrganizationId(testutil.OrganizationId).OrganizationMemberships().Update(context.Background(), membership)
+	assertions.Nil(err)
+}
+
+func TestOrganizationMembershipService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 204, Path: ""}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/organization_memberships/1Pb0DM4d3MjHrsMijLYuPo", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	var membership *model.OrganizationMembership
+	err := testutil.ModelFromTestData("/organization_membership/get.json", &membership)
+	assertions.Nil(err)
+
+	err = cma.WithOrganizationId(testutil.OrganizationId).OrganizationMemberships().Delete(context.Background(), membership)
+	assertions.Nil(err)
+}
+
+func TestInvitationService_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "/invitation/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/invitations", r.URL.Path)
+		assertions.Equal("pending-org-membership", r.Header.Get("X-Contentful-Enable-Alpha-Feature"))
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]any{"firstName": "Ada", "lastName": "Lovelace", "email": "ada@example.com", "role": "member"}, payload)
+	})
+
+	defer ts.Close()
+
+	invitation := &model.Invitation{
+		FirstName: "Ada",
+		LastName:  "Lovelace",
+		Email:     "ada@example.com",
+		Role:      model.OrganizationRoleMember,
+	}
+
+	err := cma.WithOrganizationId(testutil.OrganizationId).Invitations().Create(context.Background(), invitation)
+	assertions.Nil(err)
+	assertions.Equal("pending", invitation.Sys.Status)
+	assertions.Equal("6qDJ2ZQwS5j1r3vB0k9LxA", invitation.Sys.OrganizationMembership.Sys.ID)
+}
+
+func TestInvitationService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/invitation/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/invitations/4jMvGxTpa8mPDDP1B8Eb2v", r.URL.Path)
+		assertions.Equal("pending-org-membership", r.Header.Get("X-Contentful-Enable-Alpha-Feature"))
+	})
+
+	defer ts.Close()
+
+	invitation, err := cma.WithOrganizationId(testutil.OrganizationId).Invitations().Get(context.Background(), "4jMvGxTpa8mPDDP1B8Eb2v")
+	assertions.Nil(err)
+	assertions.Equal("ada@example.com", invitation.Email)
+}
+
+func TestTeamService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/team/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/teams", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	collection, err := cma.WithOrganizationId(testutil.OrganizationId).Teams().List(context.Background()).Next()
+	assertions.Nil(err)
+	assertions.Equal("Translators", collection.Items[0].Name)
+	assertions.Equal(2, collection.Items[0].Sys.MemberCount)
+}
+
+func TestTeamService_Upsert(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "/team/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/teams", r.URL.Path)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("Translators", payload["name"])
+	})
+
+	defer ts.Close()
+
+	team := &model.Team{Name: "Translators", Description: "Translates content to German"}
+
+	err := cma.WithOrganizationId(testutil.OrganizationId).Teams().Upsert(context.Background(), team)
+	assertions.Nil(err)
+	assertions.Equal("2wUg3XPSX2ZVUzM3tOIgKk", team.Sys.ID)
+}
+
+func TestTeamService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 204, Path: ""}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/teams/2wUg3XPSX2ZVUzM3tOIgKk", r.URL.Path)
+		assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+	})
+
+	defer ts.Close()
+
+	var team *model.Team
+	err := testutil.ModelFromTestData("/team/get.json", &team)
+	assertions.Nil(err)
+
+	err = cma.WithOrganizationId(testutil.OrganizationId).Teams().Delete(context.Background(), team)
+	assertions.Nil(err)
+}
+
+func TestTeamMembershipService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	var paths []string
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/team_membership/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		paths = append(paths, r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	memberships := cma.WithOrganizationId(testutil.OrganizationId).TeamMemberships()
+
+	collection, err := memberships.List(context.Background(), "2wUg3XPSX2ZVUzM3tOIgKk").Next()
+	assertions.Nil(err)
+	assertions.Equal("4FLrUHftHW3v2BLi9fzfjU", collection.Items[0].UserId())
+
+	_, err = memberships.ListAll(context.Background()).Next()
+	assertions.Nil(err)
+
+	assertions.Equal([]string{
+		"/organizations/" + testutil.OrganizationId + "/teams/2wUg3XPSX2ZVUzM3tOIgKk/team_memberships",
+		"/organizations/" + testutil.OrganizationId + "/team_memberships",
+	}, paths)
+}
+
+func TestTeamMembershipService_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "/team_membership/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/teams/2wUg3XPSX2ZVUzM3tOIgKk/team_memberships", r.URL.Path)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]any{"organizationMembershipId": "1Pb0DM4d3MjHrsMijLYuPo"}, payload)
+	})
+
+	defer ts.Close()
+
+	memberships := cma.WithOrganizationId(testutil.OrganizationId).TeamMemberships()
+
+	membership := &model.TeamMembership{OrganizationMembershipId: "1Pb0DM4d3MjHrsMijLYuPo"}
+	err := memberships.Create(context.Background(), "2wUg3XPSX2ZVUzM3tOIgKk", membership)
+	assertions.Nil(err)
+	assertions.Equal("0QzsVlrhbVAeo5xaMJUzRp", membership.Sys.ID)
+
+	err = memberships.Create(context.Background(), "2wUg3XPSX2ZVUzM3tOIgKk", &model.TeamMembership{})
+	assertions.NotNil(err)
+}
+
+func TestTeamMembershipService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 204, Path: ""}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/teams/2wUg3XPSX2ZVUzM3tOIgKk/team_memberships/0QzsVlrhbVAeo5xaMJUzRp", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	var membership *model.TeamMembership
+	err := testutil.ModelFromTestData("/team_membership/get.json", &membership)
+	assertions.Nil(err)
+
+	err = cma.WithOrganizationId(testutil.OrganizationId).TeamMemberships().Delete(context.Background(), membership)
+	assertions.Nil(err)
+}
+
+func TestUserService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/user/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/organizations/"+testutil.OrganizationId+"/users", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	collection, err := cma.WithOrganizationId(testutil.OrganizationId).Users().List(context.Background()).Next()
+	assertions.Nil(err)
+	assertions.Len(collection.Items, 2)
+	assertions.Equal("grace@example.com", collection.Items[0].Email)
+	assertions.Equal("4FLrUHftHW3v2BLi9fzfjU", collection.Items[0].Sys.ID)
+}
@@ -0,0 +1,162 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessTokenService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/personal_access_token/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/users/me/access_tokens", r.URL.Path)
+		assertions.Equal("true", r.URL.Query().Get("revokedAt[exists]"))
+		assertions.Equal("2024-01-01 00:00:00", r.URL.Query().Get("sys.expiresAt[lt]"))
+	})
+
+	defer ts.Close()
+
+	revoked := true
+	expiresBefore := time.Date(2024, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	collection, err := client.AccessTokens().List(context.Background(), &cma.AccessTokensFilter{
+		Revoked:       &revoked,
+		ExpiresBefore: &expiresBefore,
+	}).Next()
+	assertions.Nil(err)
+	assertions.Len(collection.Items, 2)
+	assertions.False(collection.Items[0].IsRevoked())
+	assertions.True(collection.Items[1].IsRevoked())
+	assertions.Nil(collection.Items[1].ExpiresAt())
+}
+
+func TestAccessTokenService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/personal_access_token/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/users/me/access_tokens/1kv4ZGmKpUxqb4GM6iN5Q8", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	token, err := client.AccessTokens().Get(context.Background(), "1kv4ZGmKpUxqb4GM6iN5Q8")
+	assertions.Nil(err)
+	assertions.Equal("Deployment pipeline", token.Name)
+	assertions.Equal([]string{model.AccessTokenScopeManage}, token.Scopes)
+	assertions.Equal(time.Date(2024, 10, 1, 9, 0, 0, 0, time.UTC), *token.ExpiresAt())
+
+	assertions.True(token.Matches("CFPAT-hA4FZKsq2MWzYhQ7kDqVg1BjrN8eXb9f"))
+	assertions.False(token.Matches("CFPAT-hA4FZKsq2MWzYhQ7kDqVg1BjrN8ek2Lm"))
+	assertions.False(token.Matches("hA4FZKsq2MWzYhQ7kDqVg1BjrN8eXb9f"))
+	assertions.False(token.Matches("Xb9f"))
+
+	assertions.False(token.IsExpired(time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)))
+	assertions.True(token.ExpiresWithin(time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), 31*24*time.Hour))
+	assertions.True(token.IsExpired(time.Date(2024, 10, 1, 9, 0, 0, 0, time.UTC)))
+}
+
+func TestAccessTokenService_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "/personal_access_token/create.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/users/me/access_tokens", r.URL.Path)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]any{
+			"name":      "Deployment pipeline",
+			"scopes":    []any{"content_management_manage"},
+			"expiresIn": float64(31536000),
+		}, payload)
+	})
+
+	defer ts.Close()
+
+	token := model.NewPersonalAccessToken("Deployment pipeline", 365*24*time.Hour, model.AccessTokenScopeManage)
+
+	err := client.AccessTokens().Create(context.Background(), token)
+	assertions.Nil(err)
+	assertions.Equal("CFPAT-hA4FZKsq2MWzYhQ7kDqVg1BjrN8eXb9f", token.Token)
+	assertions.Equal("1kv4ZGmKpUxqb4GM6iN5Q8", token.Sys.ID)
+}
+
+func TestAccessTokenService_Revoke(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/personal_access_token/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+		assertions.Equal("/users/me/access_tokens/1kv4ZGmKpUxqb4GM6iN5Q8/revoked", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	var token *model.PersonalAccessToken
+	err := testutil.ModelFromTestData("/personal_access_token/get.json", &token)
+	assertions.Nil(err)
+
+	err = client.AccessTokens().Revoke(context.Background(), token)
+	assertions.Nil(err)
+}
+
+func TestAccessTokenService_CheckExpiry(t *testing.T) {
+	assertions := assert.New(t)
+
+	expiresAt := func(in time.Duration) string {
+		return time.Now().Add(in).UTC().Format(time.RFC3339)
+	}
+
+	// tokens ending on Xb9f and k2Lm expire within a week, the token ending on Rt5q does not,
+	// each token is served on its own page
+	items := []string{
+		fmt.Sprintf(`{"sys": {"id": "5tX6a1RbNqs7Zp3vUu0WJh", "expiresAt": %q, "redactedValue": "k2Lm"}, "name": "Reporting"}`, expiresAt(time.Hour)),
+		fmt.Sprintf(`{"sys": {"id": "7nYwEo4cTg2bQ9sHdK6ViP", "expiresAt": %q, "redactedValue": "Rt5q"}, "name": "Preview"}`, expiresAt(90*24*time.Hour)),
+		fmt.Sprintf(`{"sys": {"id": "1kv4ZGmKpUxqb4GM6iN5Q8", "expiresAt": %q, "redactedValue": "Xb9f"}, "name": "Deployment pipeline"}`, expiresAt(48*time.Hour)),
+	}
+
+	var skips []string
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, func(w http.ResponseWriter, r *http.Request) {
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		skips = append(skips, r.URL.Query().Get("skip"))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"sys": {"type": "Array"}, "total": %d, "skip": %d, "limit": 1, "items": [%s]}`,
+			len(items), skip, items[skip])
+	}, func(r *http.Request) {
+		assertions.Equal("/users/me/access_tokens", r.URL.Path)
+		assertions.Equal("false", r.URL.Query().Get("revokedAt[exists]"))
+	})
+
+	defer ts.Close()
+
+	var logs strings.Builder
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+
+	expiring, err := client.AccessTokens().CheckExpiry(context.Background(), logger, 7*24*time.Hour,
+		"CFPAT-hA4FZKsq2MWzYhQ7kDqVg1BjrN8eXb9f",
+		"CFPAT-pL0sVq8mRw3nT6yGhB1cZ4xKjD9eRt5q",
+	)
+	assertions.Nil(err)
+	assertions.Equal([]string{"", "1", "2"}, skips)
+	assertions.Len(expiring, 1)
+	assertions.Equal("1kv4ZGmKpUxqb4GM6iN5Q8", expiring[0].Sys.ID)
+	assertions.Contains(logs.String(), `level=WARN msg="personal access token expires soon" id=1kv4ZGmKpUxqb4GM6iN5Q8 name="Deployment pipeline"`)
+	assertions.Equal(1, strings.Count(logs.String(), "\n"))
+}
@@ -0,0 +1,152 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var restorePath = "/spaces/" + testutil.SpaceID + "/environments/master/entries/5KsDBWseXY6QegucYAoacS"
+
+func restoreHandler(requests *[]recordedRequest, versions *[]string, conflict bool) testutil.HTTPHandler {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := recordedRequest{Method: r.Method, Path: r.URL.Path}
+
+		body, _ := io.ReadAll(r.Body)
+		if len(body) > 0 {
+			_ = json.Unmarshal(body, &request.Body)
+		}
+
+		*requests = append(*requests, request)
+
+		if r.Method == http.MethodPut {
+			*versions = append(*versions, r.Header.Get("X-Contentful-Version"))
+		}
+
+		switch {
+		case r.URL.Path == restorePath+"/snapshots/4wvZcEWRDngGJLTlxVtrkY":
+			_, _ = w.Write([]byte(testutil.ReadTestData("entry_snapshot/get.json")))
+		case r.Method == http.MethodPut && conflict:
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"sys": {"type": "Error", "id": "VersionMismatch"}, "message": "Version mismatch"}`))
+		case r.Method == http.MethodPut && r.URL.Path == restorePath:
+			var entry model.Entry
+			_ = json.Unmarshal([]byte(testutil.ReadTestData("entry/get.json")), &entry)
+			entry.Fields = request.Body["fields"].(map[string]any)
+			entry.Sys.Version = 2
+			_ = json.NewEncoder(w).Encode(entry)
+		default:
+			_, _ = w.Write([]byte(testutil.ReadTestData("entry/get.json")))
+		}
+	}
+}
+
+func TestEntryService_Restore_SelectedFields(t *testing.T) {
+	assertions := assert.New(t)
+	var requests []recordedRequest
+	var versions []string
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, restoreHandler(&requests, &versions, false), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	report, err := client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries().Restore(context.Background(), "5KsDBWseXY6QegucYAoacS", "4wvZcEWRDngGJLTlxVtrkY", cma.RestoreOptions{
+		Fields:  []string{"body", "title"},
+		Locales: []string{"en-US"},
+		Publish: true,
+	})
+	assertions.Nil(err)
+
+	assertions.Len(requests, 4)
+	assertions.Equal(recordedRequest{Method: "GET", Path: restorePath + "/snapshots/4wvZcEWRDngGJLTlxVtrkY"}, requests[0])
+	assertions.Equal(recordedRequest{Method: "GET", Path: restorePath}, requests[1])
+	assertions.Equal("PUT", requests[2].Method)
+	assertions.Equal(restorePath, requests[2].Path)
+	assertions.Equal(map[string]any{
+		"title": map[string]any{"en-US": "Hello, World!"},
+		"body":  map[string]any{"en-US": "Bacon is tasty!"},
+	}, requests[2].Body["fields"])
+	assertions.Equal(recordedRequest{Method: "PUT", Path: restorePath + "/published"}, requests[3])
+	assertions.Equal([]string{"1", "2"}, versions)
+
+	assertions.False(report.DryRun)
+	assertions.True(report.Updated)
+	assertions.True(report.Published)
+	assertions.Equal("4wvZcEWRDngGJLTlxVtrkY", report.Snapshot.Sys.ID)
+	assertions.Equal([]*model.FieldChange{
+		{Field: "body", Locale: "en-US", Kind: model.ChangeModified, From: "Bacon is healthy!", To: "Bacon is tasty!"},
+	}, report.Changes)
+}
+
+func TestEntryService_Restore_DryRun(t *testing.T) {
+	assertions := assert.New(t)
+	var requests []recordedRequest
+	var versions []string
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, restoreHandler(&requests, &versions, false), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	report, err := client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries().Restore(context.Background(), "5KsDBWseXY6QegucYAoacS", "4wvZcEWRDngGJLTlxVtrkY", cma.RestoreOptions{DryRun: true})
+	assertions.Nil(err)
+
+	assertions.Len(requests, 2)
+	assertions.Empty(versions)
+	assertions.True(report.DryRun)
+	assertions.True(report.Updated)
+	assertions.False(report.Published)
+	assertions.Len(report.Changes, 4)
+	assertions.Equal("tags[en-US] added", report.Changes[2].String())
+	assertions.Equal("Bacon is healthy!", report.Entry.Fields["body"].(map[string]any)["en-US"])
+}
+
+func TestEntryService_Restore_VersionMismatch(t *testing.T) {
+	assertions := assert.New(t)
+	var requests []recordedRequest
+	var versions []string
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, restoreHandler(&requests, &versions, true), func(r *http.Request) {})
+
+	defer ts.Close()
+
+	report, err := client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Entries().Restore(context.Background(), "5KsDBWseXY6QegucYAoacS", "4wvZcEWRDngGJLTlxVtrkY", cma.RestoreOptions{Publish: true})
+
+	var versionMismatch common.VersionMismatchError
+	assertions.True(errors.As(err, &versionMismatch))
+	assertions.Len(requests, 3)
+	assertions.Equal([]string{"1"}, versions)
+	assertions.False(report.Updated)
+	assertions.False(report.Published)
+}
+
+func TestEntry_ApplyChanges(t *testing.T) {
+	assertions := assert.New(t)
+
+	entry := &model.Entry{
+		Fields: map[string]any{
+			"title": map[string]string{"en-US": "Hello", "de-DE": "Hallo"},
+			"slug":  map[string]any{"en-US": "hello"},
+		},
+	}
+
+	entry.ApplyChanges(
+		&model.FieldChange{Field: "title", Locale: "de-DE", Kind: model.ChangeRemoved, From: "Hallo"},
+		&model.FieldChange{Field: "slug", Locale: "en-US", Kind: model.ChangeRemoved, From: "hello"},
+		&model.FieldChange{Field: "rating", Locale: "en-US", Kind: model.ChangeAdded, To: 4},
+	)
+
+	assertions.Equal(map[string]any{
+		"title":  map[string]any{"en-US": "Hello"},
+		"rating": map[string]any{"en-US": 4},
+	}, entry.Fields)
+}
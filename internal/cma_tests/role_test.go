@@ -0,0 +1,162 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/role.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/roles", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	collection, err := cma.WithSpaceId(testutil.SpaceID).Roles().List(context.Background()).Next()
+	assertions.Nil(err)
+	assertions.Len(collection.Items, 2)
+
+	editor := collection.Items[1]
+	assertions.Equal("Editor", editor.Name)
+	assertions.True(editor.Policies[0].Actions.IsAll())
+	assertions.True(editor.Policies[0].Actions.Contains(model.RoleActionPublish))
+	assertions.Equal(model.ConstraintAnd{model.ConstraintEquals{Doc: "sys.type", Value: "Entry"}}, editor.Policies[0].Constraint)
+	assertions.Equal(model.RoleActions{"read"}, editor.Permissions[model.PermissionContentModel])
+}
+
+func TestRoleService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/role/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/roles/3fAs1qyhAZq8GptHdvAMHd", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	role, err := cma.WithSpaceId(testutil.SpaceID).Roles().Get(context.Background(), "3fAs1qyhAZq8GptHdvAMHd")
+	assertions.Nil(err)
+	assertions.Equal("German translator", role.Name)
+
+	assertions.Equal(model.ConstraintAnd{
+		model.ConstraintEquals{Doc: "sys.type", Value: "Entry"},
+		model.ConstraintEquals{Doc: "sys.contentType.sys.id", Value: "blogPost"},
+		model.ConstraintPaths{"fields.%.de-DE"},
+	}, role.Policies[0].Constraint)
+
+	assertions.Equal(model.ConstraintOr{
+		model.ConstraintIn{Doc: "sys.contentType.sys.id", Values: []string{"settings", "navigation"}},
+		model.ConstraintIn{Doc: "metadata.tags.sys.id", Values: []string{"legal"}},
+	}, role.Policies[2].Constraint)
+
+	constraints := role.Policies[3].Constraint.(model.ConstraintAnd)
+	assertions.Equal(model.ConstraintNot{Constraint: model.ConstraintEquals{Doc: "sys.id", Value: "homepage"}}, constraints[1])
+	assertions.IsType(model.ConstraintRaw{}, constraints[2])
+
+	assertions.True(role.Permissions[model.PermissionSettings].IsAll())
+	assertions.Equal(model.RoleActions{}, role.Permissions[model.PermissionTags])
+}
+
+func TestRoleService_RoundTrip(t *testing.T) {
+	assertions := assert.New(t)
+
+	expected := testutil.ReadTestData("role/get.json")
+
+	var role model.Role
+	err := json.Unmarshal([]byte(expected), &role)
+	assertions.Nil(err)
+
+	actual, err := json.Marshal(&role)
+	assertions.Nil(err)
+	assertions.JSONEq(expected, string(actual))
+}
+
+func TestRoleService_Upsert_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "/role/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/roles", r.URL.Path)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("German translator", payload["name"])
+		assertions.Equal(map[string]any{"ContentModel": []any{"read"}, "Settings": "all"}, payload["permissions"])
+		assertions.Equal([]any{
+			map[string]any{
+				"effect":  "allow",
+				"actions": []any{"read", "update"},
+				"constraint": map[string]any{"and": []any{
+					map[string]any{"equals": []any{map[string]any{"doc": "sys.type"}, "Entry"}},
+					map[string]any{"equals": []any{map[string]any{"doc": "sys.contentType.sys.id"}, "blogPost"}},
+					map[string]any{"paths": []any{map[string]any{"doc": "fields.%.de-DE"}}},
+				}},
+			},
+		}, payload["policies"])
+	})
+
+	defer ts.Close()
+
+	role := &model.Role{
+		Name: "German translator",
+		Policies: []*model.RolePolicy{
+			model.AllowPolicy(model.RoleActionRead, model.RoleActionUpdate).Entries().ContentType("blogPost").Locales("de-DE").Build(),
+		},
+		Permissions: map[string]model.RoleActions{
+			model.PermissionContentModel: {model.RoleActionRead},
+			model.PermissionSettings:     {model.RoleActionAll},
+		},
+	}
+
+	err := cma.WithSpaceId(testutil.SpaceID).Roles().Upsert(context.Background(), role)
+	assertions.Nil(err)
+	assertions.Equal("3fAs1qyhAZq8GptHdvAMHd", role.Sys.ID)
+}
+
+func TestRoleService_Upsert_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/role/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/roles/3fAs1qyhAZq8GptHdvAMHd", r.URL.Path)
+		assertions.Equal("3", r.Header.Get("X-Contentful-Version"))
+	})
+
+	defer ts.Close()
+
+	var role *model.Role
+	err := testutil.ModelFromTestData("/role/get.json", &role)
+	assertions.Nil(err)
+
+	err = cma.WithSpaceId(testutil.SpaceID).Roles().Upsert(context.Background(), role)
+	assertions.Nil(err)
+}
+
+func TestRoleService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 204, Path: ""}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/roles/3fAs1qyhAZq8GptHdvAMHd", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	var role *model.Role
+	err := testutil.ModelFromTestData("/role/get.json", &role)
+	assertions.Nil(err)
+
+	err = cma.WithSpaceId(testutil.SpaceID).Roles().Delete(context.Background(), role)
+	assertions.Nil(err)
+}
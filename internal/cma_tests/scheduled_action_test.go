@@ -0,0 +1,221 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	cmacommon "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledActionService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma2, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "scheduled_action/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/scheduled_actions", r.URL.Path)
+		assertions.Equal("master", r.URL.Query().Get("environment.sys.id"))
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", r.URL.Query().Get("entity.sys.id"))
+		assertions.Equal("scheduled,failed", r.URL.Query().Get("sys.status[in]"))
+	})
+
+	defer ts.Close()
+
+	actions, err := cma2.WithSpaceId(testutil.SpaceID).WithEnvironment("master").ScheduledActions().List(context.Background(), &cma.ScheduledActionsFilter{
+		EntityIds: []string{"5KsDBWseXY6QegucYAoacS"},
+		Status:    []model.ScheduledActionStatus{model.ScheduledActionStatusScheduled, model.ScheduledActionStatusFailed},
+	}).Next()
+	assertions.Nil(err)
+	assertions.Len(actions.Items, 2)
+	assertions.Equal(model.ScheduledActionPublish, actions.Items[0].Action)
+	assertions.Equal(model.ScheduledActionStatusScheduled, actions.Items[0].Sys.Status)
+}
+
+func TestScheduledActionService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	var tests = []struct {
+		resultValidation func(assertions *assert.Assertions, action *model.ScheduledAction, err error)
+		path             string
+		name             string
+		statusCode       int
+	}{
+		{
+			resultValidation: func(assertions *assert.Assertions, action *model.ScheduledAction, err error) {
+				assertions.Nil(err)
+				assertions.Equal("Europe/Amsterdam", action.ScheduledFor.Timezone)
+				assertions.True(time.Date(2119, 9, 2, 14, 0, 0, 0, time.UTC).Equal(action.ScheduledFor.Datetime))
+
+				local, err := action.ScheduledFor.Local()
+				assertions.Nil(err)
+				assertions.Equal(16, local.Hour())
+				assertions.Equal("Europe/Amsterdam", local.Location().String())
+			},
+			path:       "scheduled_action/get.json",
+			name:       "found",
+			statusCode: 200,
+		},
+		{
+			resultValidation: func(assertions *assert.Assertions, action *model.ScheduledAction, err error) {
+				assertions.NotNil(err)
+				var contentfulError common.NotFoundError
+				assertions.True(errors.As(err, &contentfulError))
+			},
+			path:       "error_notfound.json",
+			statusCode: 404,
+			name:       "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: tt.statusCode, Path: tt.path}, nil, func(r *http.Request) {
+				assertions.Equal("GET", r.Method)
+				assertions.Equal("/spaces/"+testutil.SpaceID+"/scheduled_actions/3A13SXSDwO8c46NrjigFYT", r.URL.Path)
+				assertions.Equal("master", r.URL.Query().Get("environment.sys.id"))
+			})
+
+			defer ts.Close()
+
+			action, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").ScheduledActions().Get(context.Background(), "3A13SXSDwO8c46NrjigFYT")
+			tt.resultValidation(assertions, action, err)
+		})
+	}
+}
+
+func TestScheduledActionService_Upsert_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "scheduled_action/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/scheduled_actions", r.URL.Path)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("publish", payload["action"])
+		scheduledFor := payload["scheduledFor"].(map[string]interface{})
+		assertions.Equal("2119-09-02T16:00:00+02:00", scheduledFor["datetime"])
+		assertions.Equal("Europe/Amsterdam", scheduledFor["timezone"])
+		environment := payload["environment"].(map[string]interface{})["sys"].(map[string]interface{})
+		assertions.Equal("master", environment["id"])
+		entity := payload["entity"].(map[string]interface{})["sys"].(map[string]interface{})
+		assertions.Equal("Release", entity["linkType"])
+	})
+
+	defer ts.Close()
+
+	scheduledFor, err := model.NewScheduledFor(time.Date(2119, 9, 2, 14, 0, 0, 0, time.UTC), "Europe/Amsterdam")
+	assertions.Nil(err)
+
+	action := model.NewScheduledAction("", model.ScheduledEntityRelease, "release1", model.ScheduledActionPublish, scheduledFor)
+
+	err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").ScheduledActions().Upsert(context.Background(), action)
+	assertions.Nil(err)
+	assertions.Equal("3A13SXSDwO8c46NrjigFYT", action.Sys.ID)
+}
+
+func TestScheduledActionService_Upsert_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "scheduled_action/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/scheduled_actions/3A13SXSDwO8c46NrjigFYT", r.URL.Path)
+		assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+	})
+
+	defer ts.Close()
+
+	var action *model.ScheduledAction
+	err := testutil.ModelFromTestData("scheduled_action/get.json", &action)
+	assertions.Nil(err)
+
+	err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").ScheduledActions().Upsert(context.Background(), action)
+	assertions.Nil(err)
+}
+
+func TestScheduledActionService_Cancel(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "scheduled_action/canceled.json"}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/scheduled_actions/3A13SXSDwO8c46NrjigFYT", r.URL.Path)
+		assertions.Equal("master", r.URL.Query().Get("environment.sys.id"))
+	})
+
+	defer ts.Close()
+
+	var action *model.ScheduledAction
+	err := testutil.ModelFromTestData("scheduled_action/get.json", &action)
+	assertions.Nil(err)
+
+	err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").ScheduledActions().Cancel(context.Background(), action)
+	assertions.Nil(err)
+	assertions.Equal(model.ScheduledActionStatusCanceled, action.Sys.Status)
+	assertions.False(action.IsScheduled())
+}
+
+func TestScheduledActionService_Conflicts(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "scheduled_action/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/scheduled_actions", r.URL.Path)
+		assertions.Equal("scheduled", r.URL.Query().Get("sys.status[in]"))
+	})
+
+	defer ts.Close()
+
+	scheduledFor, err := model.NewScheduledFor(time.Date(2119, 9, 2, 14, 20, 0, 0, time.UTC), "America/New_York")
+	assertions.Nil(err)
+
+	action := model.NewScheduledAction("master", model.ScheduledEntityEntry, "5KsDBWseXY6QegucYAoacS", model.ScheduledActionUnpublish, scheduledFor)
+
+	conflicts, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").ScheduledActions().Conflicts(context.Background(), action, 15*time.Minute)
+	assertions.Nil(err)
+	assertions.Len(conflicts, 1)
+	assertions.Equal("6YK4PqT2k2ghgdk4Amy2gX", conflicts[0].Sys.ID)
+
+	conflicts, err = cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").ScheduledActions().Conflicts(context.Background(), action, 30*time.Minute)
+	assertions.Nil(err)
+	assertions.Len(conflicts, 2)
+}
+
+func TestNewScheduledFor_InvalidTimezone(t *testing.T) {
+	assertions := assert.New(t)
+
+	_, err := model.NewScheduledFor(time.Now(), "Mars/Olympus_Mons")
+	assertions.NotNil(err)
+}
+
+func TestScheduledActionService_List_CollectAll(t *testing.T) {
+	assertions := assert.New(t)
+
+	var skips []string
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, testutil.PagedHandler("scheduled_action/list.json", 1), func(r *http.Request) {
+		skips = append(skips, r.URL.Query().Get("skip"))
+	})
+
+	defer ts.Close()
+
+	actions, err := cmacommon.CollectAll(cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").ScheduledActions().List(context.Background(), nil))
+	assertions.Nil(err)
+	assertions.Equal([]string{"", "1"}, skips)
+
+	ids := make([]string, 0, len(actions))
+	for _, action := range actions {
+		ids = append(ids, action.Sys.ID)
+	}
+
+	assertions.Equal([]string{"3A13SXSDwO8c46NrjigFYT", "6YK4PqT2k2ghgdk4Amy2gX"}, ids)
+}
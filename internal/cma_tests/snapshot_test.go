@@ -0,0 +1,134 @@
+package cma_tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntrySnapshotService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/entry_snapshot/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/entries/5KsDBWseXY6QegucYAoacS/snapshots/4wvZcEWRDngGJLTlxVtrkY", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	snapshot, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").EntrySnapshots().Get(context.Background(), "5KsDBWseXY6QegucYAoacS", "4wvZcEWRDngGJLTlxVtrkY")
+	assertions.Nil(err)
+	assertions.Equal(model.SnapshotTypePublish, snapshot.Sys.SnapshotType)
+	assertions.Equal("Entry", snapshot.Sys.SnapshotEntityType)
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", snapshot.Snapshot.Sys.ID)
+	assertions.Equal("Hallo, Welt!", snapshot.Snapshot.Fields["title"].(map[string]any)["de-DE"])
+}
+
+func TestEntrySnapshotService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/entry_snapshot/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/entries/5KsDBWseXY6QegucYAoacS/snapshots", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	collection, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").EntrySnapshots().List(context.Background(), "5KsDBWseXY6QegucYAoacS").Next()
+	assertions.Nil(err)
+	assertions.Len(collection.Items, 2)
+	assertions.Equal("2ZFkhtvQxJiHUzzn9ORDXy", collection.Items[1].Sys.ID)
+
+	changes := collection.Items[1].Diff(collection.Items[0])
+	assertions.Equal([]*model.FieldChange{
+		{Field: "body", Locale: "de-DE", Kind: model.ChangeAdded, To: "Speck ist lecker!"},
+		{Field: "tags", Locale: "en-US", Kind: model.ChangeAdded, To: []any{"food", "bacon"}},
+		{Field: "title", Locale: "de-DE", Kind: model.ChangeAdded, To: "Hallo, Welt!"},
+	}, changes)
+}
+
+func TestEntrySnapshot_DiffEntry(t *testing.T) {
+	assertions := assert.New(t)
+
+	var snapshot *model.EntrySnapshot
+	err := testutil.ModelFromTestData("/entry_snapshot/get.json", &snapshot)
+	assertions.Nil(err)
+
+	var entry *model.Entry
+	err = testutil.ModelFromTestData("/entry/get.json", &entry)
+	assertions.Nil(err)
+
+	changes := snapshot.DiffEntry(entry)
+	assertions.Equal([]*model.FieldChange{
+		{Field: "body", Locale: "de-DE", Kind: model.ChangeRemoved, From: "Speck ist lecker!"},
+		{Field: "body", Locale: "en-US", Kind: model.ChangeModified, From: "Bacon is tasty!", To: "Bacon is healthy!"},
+		{Field: "tags", Locale: "en-US", Kind: model.ChangeRemoved, From: []any{"food", "bacon"}},
+		{Field: "title", Locale: "de-DE", Kind: model.ChangeRemoved, From: "Hallo, Welt!"},
+	}, changes)
+	assertions.Equal("body[en-US] modified", changes[1].String())
+
+	assertions.Empty(snapshot.DiffEntry(snapshot.Snapshot))
+}
+
+func TestDiffEntries_ConvertsValues(t *testing.T) {
+	assertions := assert.New(t)
+
+	var entry *model.Entry
+	err := testutil.ModelFromTestData("/entry/get.json", &entry)
+	assertions.Nil(err)
+
+	entry.Fields["rating"] = map[string]any{"en-US": float64(4)}
+
+	changed := &model.Entry{
+		Fields: map[string]any{
+			"title":  map[string]string{"en-US": "Hello, World!"},
+			"body":   map[string]string{"en-US": "Bacon is healthy!"},
+			"rating": map[string]int{"en-US": 4},
+		},
+	}
+
+	assertions.Empty(model.DiffEntries(entry, changed))
+
+	changes := model.DiffEntries(nil, changed)
+	assertions.Len(changes, 3)
+	assertions.Equal(model.ChangeAdded, changes[0].Kind)
+}
+
+func TestContentTypeSnapshotService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/content_type_snapshot/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/content_types/hfM9RCJIk0wIm06WkEOQY/snapshots/0kjoTlNS2ck0Ee8Edkc1yl", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	snapshot, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").ContentTypeSnapshots().Get(context.Background(), "hfM9RCJIk0wIm06WkEOQY", "0kjoTlNS2ck0Ee8Edkc1yl")
+	assertions.Nil(err)
+	assertions.Equal("ContentType", snapshot.Sys.SnapshotEntityType)
+	assertions.Equal("Blog Post", snapshot.Snapshot.Name)
+	assertions.Len(snapshot.Snapshot.Fields, 2)
+	assertions.True(snapshot.Snapshot.Fields[0].Required)
+}
+
+func TestContentTypeSnapshotService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/content_type_snapshot/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/environments/master/content_types/hfM9RCJIk0wIm06WkEOQY/snapshots", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	collection, err := cma.WithSpaceId(testutil.SpaceID).WithEnvironment("master").ContentTypeSnapshots().List(context.Background(), "hfM9RCJIk0wIm06WkEOQY").Next()
+	assertions.Nil(err)
+	assertions.Len(collection.Items, 1)
+	assertions.Equal("title", collection.Items[0].Snapshot.DisplayField)
+}
@@ -0,0 +1,171 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpaceMembershipService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/space_membership/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/space_memberships", r.URL.Path)
+		assertions.Equal("100", r.URL.Query().Get("limit"))
+	})
+
+	defer ts.Close()
+
+	memberships := cma.WithSpaceId(testutil.SpaceID).SpaceMemberships().List(context.Background())
+	memberships.GetQuery().Limit(100)
+
+	collection, err := memberships.Next()
+	assertions.Nil(err)
+	assertions.Len(collection.Items, 2)
+	assertions.Equal([]string{"3fAs1qyhAZq8GptHdvAMHd"}, collection.Items[0].RoleIds())
+	assertions.True(collection.Items[1].Admin)
+	assertions.Equal("8790UHtytgfyjgluyjkJG687", collection.Items[1].User.Sys.ID)
+}
+
+func TestSpaceMembershipService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/space_membership/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/space_memberships/8960YTIOjg6jknUYIjhg", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	membership, err := cma.WithSpaceId(testutil.SpaceID).SpaceMemberships().Get(context.Background(), "8960YTIOjg6jknUYIjhg")
+	assertions.Nil(err)
+	assertions.False(membership.Admin)
+	assertions.Equal("Role", membership.Roles[0].Sys.LinkType)
+}
+
+func TestSpaceMembershipService_Upsert_Invite(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "/space_membership/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/space_memberships", r.URL.Path)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]any{
+			"admin": false,
+			"email": "ada@example.com",
+			"roles": []any{
+				map[string]any{"sys": map[string]any{"type": "Link", "linkType": "Role", "id": "3fAs1qyhAZq8GptHdvAMHd"}},
+			},
+		}, payload)
+	})
+
+	defer ts.Close()
+
+	membership := model.NewSpaceMembership("ada@example.com", "3fAs1qyhAZq8GptHdvAMHd")
+
+	err := cma.WithSpaceId(testutil.SpaceID).SpaceMemberships().Upsert(context.Background(), membership)
+	assertions.Nil(err)
+	assertions.Equal("8960YTIOjg6jknUYIjhg", membership.Sys.ID)
+	assertions.Equal("4FLrUHftHW3v2BLi9fzfjU", membership.User.Sys.ID)
+}
+
+func TestSpaceMembershipService_Upsert_Admin(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/space_membership/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/space_memberships/8960YTIOjg6jknUYIjhg", r.URL.Path)
+		assertions.Equal("2", r.Header.Get("X-Contentful-Version"))
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(true, payload["admin"])
+		assertions.Equal([]any{}, payload["roles"])
+	})
+
+	defer ts.Close()
+
+	var membership *model.SpaceMembership
+	err := testutil.ModelFromTestData("/space_membership/get.json", &membership)
+	assertions.Nil(err)
+
+	membership.AssignAdmin()
+
+	err = cma.WithSpaceId(testutil.SpaceID).SpaceMemberships().Upsert(context.Background(), membership)
+	assertions.Nil(err)
+}
+
+func TestSpaceMembershipService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 204, Path: ""}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/space_memberships/8960YTIOjg6jknUYIjhg", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	var membership *model.SpaceMembership
+	err := testutil.ModelFromTestData("/space_membership/get.json", &membership)
+	assertions.Nil(err)
+
+	err = cma.WithSpaceId(testutil.SpaceID).SpaceMemberships().Delete(context.Background(), membership)
+	assertions.Nil(err)
+}
+
+func TestTeamSpaceMembershipService_Upsert_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "/team_space_membership/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/team_space_memberships", r.URL.Path)
+		assertions.Equal("2wUg3XPSX2ZVUzM3tOIgKk", r.Header.Get("X-Contentful-Team"))
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(false, payload["admin"])
+		assertions.Len(payload["roles"], 1)
+	})
+
+	defer ts.Close()
+
+	membership := model.NewTeamSpaceMembership("2wUg3XPSX2ZVUzM3tOIgKk", "3fAs1qyhAZq8GptHdvAMHd")
+
+	err := cma.WithSpaceId(testutil.SpaceID).TeamSpaceMemberships().Upsert(context.Background(), membership)
+	assertions.Nil(err)
+	assertions.Equal("6Ezg9h3P6CNI3w8bCoB6Ag", membership.Sys.ID)
+	assertions.Equal("2wUg3XPSX2ZVUzM3tOIgKk", membership.TeamId())
+}
+
+func TestTeamSpaceMembershipService_Upsert_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/team_space_membership/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/team_space_memberships/6Ezg9h3P6CNI3w8bCoB6Ag", r.URL.Path)
+		assertions.Empty(r.Header.Get("X-Contentful-Team"))
+	})
+
+	defer ts.Close()
+
+	var membership *model.TeamSpaceMembership
+	err := testutil.ModelFromTestData("/team_space_membership/get.json", &membership)
+	assertions.Nil(err)
+
+	membership.AssignRoles("3fAs1qyhAZq8GptHdvAMHd", "editor")
+
+	err = cma.WithSpaceId(testutil.SpaceID).TeamSpaceMemberships().Upsert(context.Background(), membership)
+	assertions.Nil(err)
+}
@@ -0,0 +1,229 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/common"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpaceService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/space/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/cfexampleapi", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	space, err := client.Spaces().Get(context.Background(), "cfexampleapi")
+	assertions.Nil(err)
+	assertions.Equal("Contentful Example API", space.Name)
+	assertions.Equal("org1", space.OrganizationId())
+}
+
+func TestSpaceService_ListByOrganization(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/space/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	spaces, err := client.Spaces().ListByOrganization(context.Background(), testutil.OrganizationId)
+	assertions.Nil(err)
+	assertions.Len(spaces, 2)
+	assertions.Equal("cfexampleapi", spaces[0].Sys.ID)
+	assertions.Equal("Website", spaces[1].Name)
+}
+
+func TestSpaceService_ListByOrganization_Paginated(t *testing.T) {
+	assertions := assert.New(t)
+
+	var skips []string
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, testutil.PagedHandler("space/list.json", 1), func(r *http.Request) {
+		assertions.Equal("/spaces", r.URL.Path)
+		skips = append(skips, r.URL.Query().Get("skip"))
+	})
+
+	defer ts.Close()
+
+	spaces, err := client.Spaces().ListByOrganization(context.Background(), testutil.OrganizationId)
+	assertions.Nil(err)
+	assertions.Equal([]string{"", "1", "2"}, skips)
+	assertions.Len(spaces, 2)
+	assertions.Equal("cfexampleapi", spaces[0].Sys.ID)
+	assertions.Equal("Website", spaces[1].Name)
+}
+
+func TestSpaceService_Upsert_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "/space/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/spaces", r.URL.Path)
+		assertions.Equal(testutil.OrganizationId, r.Header.Get("X-Contentful-Organization"))
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]any{"name": "Contentful Example API", "defaultLocale": "de-DE"}, payload)
+	})
+
+	defer ts.Close()
+
+	space := model.NewSpace(testutil.OrganizationId, "Contentful Example API", "de-DE")
+
+	err := client.Spaces().Upsert(context.Background(), space)
+	assertions.Nil(err)
+	assertions.Equal("cfexampleapi", space.Sys.ID)
+
+	assertions.Equal(model.DefaultLocale, model.NewSpace(testutil.OrganizationId, "Website", "").DefaultLocale)
+}
+
+func TestSpaceService_Upsert_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/space/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+		assertions.Equal("/spaces/cfexampleapi", r.URL.Path)
+		assertions.Equal("2", r.Header.Get("X-Contentful-Version"))
+		assertions.Empty(r.Header.Get("X-Contentful-Organization"))
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(map[string]any{"name": "Example"}, payload)
+	})
+
+	defer ts.Close()
+
+	var space *model.Space
+	err := testutil.ModelFromTestData("/space/get.json", &space)
+	assertions.Nil(err)
+
+	space.Name = "Example"
+
+	err = client.Spaces().Upsert(context.Background(), space)
+	assertions.Nil(err)
+}
+
+func TestSpaceService_Upsert_PlanLimit(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 403, Path: "error_plan_limit.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+	})
+
+	defer ts.Close()
+
+	err := client.Spaces().Upsert(context.Background(), model.NewSpace(testutil.OrganizationId, "Website", ""))
+
+	var limitError common.PlanLimitExceededError
+	assertions.True(errors.As(err, &limitError))
+	assertions.Equal("You have reached the limit of 2 spaces for your organization plan", err.Error())
+
+	var errorResponse common.ErrorResponse
+	assertions.True(errors.As(err, &errorResponse))
+	assertions.Equal("AccessDenied", errorResponse.Sys.ID)
+}
+
+func TestSpaceService_Upsert_AccessDenied(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 403, Path: "error_access_denied_limit.json"}, nil, func(r *http.Request) {})
+
+	defer ts.Close()
+
+	err := client.Spaces().Upsert(context.Background(), model.NewSpace(testutil.OrganizationId, "Website", ""))
+
+	var limitError common.PlanLimitExceededError
+	assertions.False(errors.As(err, &limitError))
+
+	var errorResponse common.ErrorResponse
+	assertions.True(errors.As(err, &errorResponse))
+}
+
+func TestSpaceService_Upsert_Forbidden(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 403, Path: "error_forbidden.json"}, nil, func(r *http.Request) {})
+
+	defer ts.Close()
+
+	err := client.Spaces().Upsert(context.Background(), model.NewSpace(testutil.OrganizationId, "Website", ""))
+	assertions.NotNil(err)
+
+	var limitError common.PlanLimitExceededError
+	assertions.False(errors.As(err, &limitError))
+}
+
+func TestIsPlanLimitExceeded(t *testing.T) {
+	assertions := assert.New(t)
+
+	// the response bodies which are recognised as plan limits, and similar ones which are not
+	cases := []struct {
+		fileName string
+		expected bool
+	}{
+		{fileName: "error_plan_limit.json", expected: true},
+		{fileName: "error_usage_exceeded.json", expected: true},
+		{fileName: "error_forbidden.json", expected: false},
+		{fileName: "error_access_denied_limit.json", expected: false},
+		{fileName: "error_not_found_limit.json", expected: false},
+	}
+
+	for _, c := range cases {
+		var response common.ErrorResponse
+		err := testutil.ModelFromTestData(c.fileName, &response)
+		assertions.Nil(err)
+		assertions.Equal(c.expected, common.IsPlanLimitExceeded(&response), c.fileName)
+	}
+}
+
+func TestSpaceService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 204}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/spaces/cfexampleapi", r.URL.Path)
+		assertions.Equal("2", r.Header.Get("X-Contentful-Version"))
+	})
+
+	defer ts.Close()
+
+	var space *model.Space
+	err := testutil.ModelFromTestData("/space/get.json", &space)
+	assertions.Nil(err)
+
+	err = client.Spaces().Delete(context.Background(), space)
+	assertions.Nil(err)
+}
+
+func TestOrganizationService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/organization/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/organizations", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	collection, err := client.Organizations().List(context.Background()).Next()
+	assertions.Nil(err)
+	assertions.Len(collection.Items, 2)
+	assertions.Equal("Acme", collection.Items[0].Name)
+	assertions.Equal("org2", collection.Items[1].Sys.ID)
+}
@@ -0,0 +1,124 @@
+package cma_tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadService_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	content := bytes.Repeat([]byte("contentful"), 10000)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "upload/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/uploads", r.URL.Path)
+		assertions.Equal(int64(len(content)), r.ContentLength)
+
+		body, err := io.ReadAll(r.Body)
+		assertions.Nil(err)
+		assertions.Equal(content, body)
+	})
+
+	defer ts.Close()
+
+	var lastWritten, lastTotal int64
+	upload, err := client.WithSpaceId(testutil.SpaceID).Uploads().Create(context.Background(), bytes.NewReader(content), &cma.UploadOptions{
+		Progress: func(written int64, total int64) {
+			assertions.GreaterOrEqual(written, lastWritten)
+			lastWritten, lastTotal = written, total
+		},
+	})
+	assertions.Nil(err)
+	assertions.Equal("2DNvIbYNELgqLJUkgTeIOV", upload.Sys.ID)
+	assertions.Equal("2015-05-18T11:29:46.809Z", upload.Sys.ExpiresAt)
+	assertions.Equal(int64(len(content)), lastWritten)
+	assertions.Equal(int64(len(content)), lastTotal)
+
+	uploadFrom := upload.UploadFrom()
+	assertions.Equal("Link", uploadFrom.Sys.Type)
+	assertions.Equal("Upload", uploadFrom.Sys.LinkType)
+	assertions.Equal("2DNvIbYNELgqLJUkgTeIOV", uploadFrom.Sys.ID)
+}
+
+func TestUploadService_Create_Chunked(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "upload/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal([]string{"chunked"}, r.TransferEncoding)
+
+		body, err := io.ReadAll(r.Body)
+		assertions.Nil(err)
+		assertions.Equal("streamed content", string(body))
+	})
+
+	defer ts.Close()
+
+	// a reader without a known length is streamed
+	reader := io.MultiReader(strings.NewReader("streamed "), strings.NewReader("content"))
+
+	upload, err := client.WithSpaceId(testutil.SpaceID).Uploads().Create(context.Background(), reader, nil)
+	assertions.Nil(err)
+	assertions.Equal("2DNvIbYNELgqLJUkgTeIOV", upload.Sys.ID)
+}
+
+func TestUploadService_Create_TooLarge(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{}, nil, func(r *http.Request) {
+		assertions.Fail("no request expected")
+	})
+
+	defer ts.Close()
+
+	_, err := client.WithSpaceId(testutil.SpaceID).Uploads().Create(context.Background(), strings.NewReader(""), &cma.UploadOptions{
+		Size: cma.MaxUploadSize + 1,
+	})
+	assertions.NotNil(err)
+}
+
+func TestUploadService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 404, Path: "error_notfound.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/uploads/2DNvIbYNELgqLJUkgTeIOV", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	_, err := client.WithSpaceId(testutil.SpaceID).Uploads().Get(context.Background(), "2DNvIbYNELgqLJUkgTeIOV")
+	var contentfulError common.NotFoundError
+	assertions.True(errors.As(err, &contentfulError))
+}
+
+func TestUploadService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 204}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/uploads/2DNvIbYNELgqLJUkgTeIOV", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	var upload *model.Upload
+	err := testutil.ModelFromTestData("upload/get.json", &upload)
+	assertions.Nil(err)
+
+	err = client.WithSpaceId(testutil.SpaceID).Uploads().Delete(context.Background(), upload)
+	assertions.Nil(err)
+}
@@ -0,0 +1,91 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/webhook"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSigningSecretService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/webhook_signing_secret/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhook_settings/signing_secret", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	secret, err := cma.WithSpaceId(testutil.SpaceID).WebhookSigningSecret().Get(context.Background())
+	assertions.Nil(err)
+	assertions.Equal("oLTh", secret.RedactedValue)
+	assertions.Empty(secret.Value)
+}
+
+func TestWebhookSigningSecretService_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	value := "23456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZoLTh"
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/webhook_signing_secret/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhook_settings/signing_secret", r.URL.Path)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal(value, payload["value"])
+	})
+
+	defer ts.Close()
+
+	secret, err := cma.WithSpaceId(testutil.SpaceID).WebhookSigningSecret().Create(context.Background(), value)
+	assertions.Nil(err)
+	assertions.Equal(value, secret.Value)
+	assertions.Equal("oLTh", secret.RedactedValue)
+
+	_, err = cma.WithSpaceId(testutil.SpaceID).WebhookSigningSecret().Create(context.Background(), "too-short")
+	assertions.ErrorIs(err, webhook.ErrInvalidSecret)
+}
+
+func TestWebhookSigningSecretService_Rotate(t *testing.T) {
+	assertions := assert.New(t)
+
+	var sent string
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/webhook_signing_secret/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+
+		var payload map[string]string
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		sent = payload["value"]
+	})
+
+	defer ts.Close()
+
+	secret, err := cma.WithSpaceId(testutil.SpaceID).WebhookSigningSecret().Rotate(context.Background())
+	assertions.Nil(err)
+	assertions.Nil(webhook.ValidateSecret(secret.Value))
+	assertions.Equal(sent, secret.Value)
+}
+
+func TestWebhookSigningSecretService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 204, Path: ""}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhook_settings/signing_secret", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	err := cma.WithSpaceId(testutil.SpaceID).WebhookSigningSecret().Delete(context.Background())
+	assertions.Nil(err)
+}
@@ -0,0 +1,214 @@
+package cma_tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/webhook_definition/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhook_definitions", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	collection, err := cma.WithSpaceId(testutil.SpaceID).Webhooks().List(context.Background()).Next()
+	assertions.Nil(err)
+	assertions.Len(collection.Items, 1)
+	assertions.Equal([]model.WebhookTopic{"*.*"}, collection.Items[0].Topics)
+	assertions.True(collection.Items[0].IsActive())
+}
+
+func TestWebhookService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/webhook_definition/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhook_definitions/0KzM2HxYr5O1pZ6rngAjEz", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	webhook, err := cma.WithSpaceId(testutil.SpaceID).Webhooks().Get(context.Background(), "0KzM2HxYr5O1pZ6rngAjEz")
+	assertions.Nil(err)
+	assertions.Equal("Search indexer", webhook.Name)
+	assertions.Equal([]model.WebhookTopic{"Entry.publish", "Entry.unpublish", "Asset.*"}, webhook.Topics)
+	assertions.False(webhook.IsActive())
+
+	assertions.Equal([]*model.WebhookFilter{
+		{Operator: model.WebhookFilterEquals, Doc: "sys.environment.sys.id", Value: "master"},
+		{Operator: model.WebhookFilterIn, Doc: "sys.contentType.sys.id", Values: []string{"settings", "navigation"}, Not: true},
+		{Operator: model.WebhookFilterRegexp, Doc: "sys.id", Pattern: "^blog-"},
+	}, webhook.Filters)
+
+	assertions.True(webhook.Headers[1].Secret)
+	assertions.Equal("PUT", webhook.Transformation.Method)
+	assertions.True(*webhook.Transformation.IncludeContentLength)
+	assertions.JSONEq(`{"id": "{ /payload/sys/id }"}`, string(webhook.Transformation.Body))
+}
+
+func TestWebhookService_Filters_RoundTrip(t *testing.T) {
+	assertions := assert.New(t)
+
+	var webhook map[string]any
+	err := testutil.ModelFromTestData("/webhook_definition/get.json", &webhook)
+	assertions.Nil(err)
+
+	expected, err := json.Marshal(webhook["filters"])
+	assertions.Nil(err)
+
+	var filters []*model.WebhookFilter
+	err = json.Unmarshal(expected, &filters)
+	assertions.Nil(err)
+
+	actual, err := json.Marshal(filters)
+	assertions.Nil(err)
+	assertions.JSONEq(string(expected), string(actual))
+
+	err = json.Unmarshal([]byte(`[{"equals": [{"doc": "sys.id"}, "a"], "in": [{"doc": "sys.id"}, ["a"]]}]`), &filters)
+	assertions.NotNil(err)
+
+	_, err = json.Marshal(&model.WebhookFilter{Operator: "contains", Doc: "sys.id"})
+	assertions.NotNil(err)
+}
+
+func TestWebhookService_Upsert_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 201, Path: "/webhook_definition/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("POST", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhook_definitions", r.URL.Path)
+
+		var payload map[string]any
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("Search indexer", payload["name"])
+		assertions.Equal([]any{"Entry.publish"}, payload["topics"])
+		assertions.Equal(false, payload["active"])
+		assertions.Equal([]any{
+			map[string]any{"equals": []any{map[string]any{"doc": "sys.environment.sys.id"}, "master"}},
+		}, payload["filters"])
+	})
+
+	defer ts.Close()
+
+	active := false
+	webhook := &model.Webhook{
+		Name:   "Search indexer",
+		URL:    "https://www.example.com/webhooks",
+		Topics: []model.WebhookTopic{"Entry.publish"},
+		Filters: []*model.WebhookFilter{
+			{Operator: model.WebhookFilterEquals, Doc: "sys.environment.sys.id", Value: "master"},
+		},
+		Active: &active,
+	}
+
+	err := cma.WithSpaceId(testutil.SpaceID).Webhooks().Upsert(context.Background(), webhook)
+	assertions.Nil(err)
+	assertions.Equal("0KzM2HxYr5O1pZ6rngAjEz", webhook.Sys.ID)
+}
+
+func TestWebhookService_Upsert_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/webhook_definition/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("PUT", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhook_definitions/0KzM2HxYr5O1pZ6rngAjEz", r.URL.Path)
+		assertions.Equal("2", r.Header.Get("X-Contentful-Version"))
+	})
+
+	defer ts.Close()
+
+	var webhook *model.Webhook
+	err := testutil.ModelFromTestData("/webhook_definition/get.json", &webhook)
+	assertions.Nil(err)
+
+	err = cma.WithSpaceId(testutil.SpaceID).Webhooks().Upsert(context.Background(), webhook)
+	assertions.Nil(err)
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 204, Path: ""}, nil, func(r *http.Request) {
+		assertions.Equal("DELETE", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhook_definitions/0KzM2HxYr5O1pZ6rngAjEz", r.URL.Path)
+		assertions.Equal("2", r.Header.Get("X-Contentful-Version"))
+	})
+
+	defer ts.Close()
+
+	var webhook *model.Webhook
+	err := testutil.ModelFromTestData("/webhook_definition/get.json", &webhook)
+	assertions.Nil(err)
+
+	err = cma.WithSpaceId(testutil.SpaceID).Webhooks().Delete(context.Background(), webhook)
+	assertions.Nil(err)
+}
+
+func TestWebhookCallService_List(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/webhook_call/list.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhooks/0KzM2HxYr5O1pZ6rngAjEz/calls", r.URL.Path)
+		assertions.Equal("2", r.URL.Query().Get("limit"))
+	})
+
+	defer ts.Close()
+
+	calls := cma.WithSpaceId(testutil.SpaceID).WebhookCalls().List(context.Background(), "0KzM2HxYr5O1pZ6rngAjEz")
+	calls.GetQuery().Limit(2)
+
+	collection, err := calls.Next()
+	assertions.Nil(err)
+	assertions.Equal(3, collection.Total)
+	assertions.Len(collection.Items, 2)
+	assertions.True(collection.Items[0].Succeeded())
+	assertions.False(collection.Items[1].Succeeded())
+	assertions.Nil(collection.Items[1].Request)
+}
+
+func TestWebhookCallService_Get(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/webhook_call/get.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhooks/0KzM2HxYr5O1pZ6rngAjEz/calls/8a3c1f14-7f0e-4a55-b0e3-2cbf7c1d4e77", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	call, err := cma.WithSpaceId(testutil.SpaceID).WebhookCalls().Get(context.Background(), "0KzM2HxYr5O1pZ6rngAjEz", "8a3c1f14-7f0e-4a55-b0e3-2cbf7c1d4e77")
+	assertions.Nil(err)
+	assertions.Equal("POST", call.Request.Method)
+	assertions.Equal("ContentManagement.Entry.unpublish", call.Request.Headers["X-Contentful-Topic"])
+	assertions.Equal(503, call.Response.StatusCode)
+	assertions.Equal([]string{"ServiceUnavailable"}, call.Errors)
+}
+
+func TestWebhookCallService_Health(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma, ts := testutil.MockCMAClient(t, assertions, testutil.ResponseData{StatusCode: 200, Path: "/webhook_call/health.json"}, nil, func(r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/"+testutil.SpaceID+"/webhooks/0KzM2HxYr5O1pZ6rngAjEz/health", r.URL.Path)
+	})
+
+	defer ts.Close()
+
+	health, err := cma.WithSpaceId(testutil.SpaceID).WebhookCalls().Health(context.Background(), "0KzM2HxYr5O1pZ6rngAjEz")
+	assertions.Nil(err)
+	assertions.Equal(233, health.Calls.Total)
+	assertions.Equal(230, health.Calls.Healthy)
+}
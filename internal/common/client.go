@@ -28,11 +28,15 @@ type ClientConfig struct {
 	ContentType string
 	Token       string
 	Logger      *slog.Logger
+	// Streaming passes request bodies through without buffering them. Requests
+	// with a body are not retried when the rate limit is exceeded.
+	Streaming bool
 }
 
 type Client struct {
 	httpClient common.HttpClient
 	debug      bool
+	streaming  bool
 	headers    map[string]string
 	url        *url.URL
 	logger     *slog.Logger
@@ -47,6 +51,7 @@ func NewInternalClient(config ClientConfig) *Client {
 		logger:     logger,
 		httpClient: httpClient,
 		debug:      config.Debug,
+		streaming:  config.Streaming,
 		headers: map[string]string{
 			"Authorization":           fmt.Sprintf("Bearer %s", config.Token),
 			"Content-Type":            config.ContentType,
@@ -92,7 +97,7 @@ func (c *Client) execute(ctx context.Context, method string, path string, params
 
 	var intermediateBody *bytes.Buffer
 
-	if body != nil {
+	if body != nil && !c.streaming {
 		intermediateBody = new(bytes.Buffer)
 		_, err = intermediateBody.ReadFrom(body)
 
@@ -116,6 +121,17 @@ func (c *Client) execute(ctx context.Context, method string, path string, params
 		req.Header.Set(key, value)
 	}
 
+	// a streamed body has an unknown length unless it is passed as header
+	if c.streaming && req.Header.Get("Content-Length") != "" {
+		contentLength, err := strconv.ParseInt(req.Header.Get("Content-Length"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid content length: %w", err)
+		}
+
+		req.ContentLength = contentLength
+		req.Header.Del("Content-Length")
+	}
+
 	if c.debug {
 		command, _ := http2curl.GetCurlCommand(req)
 		c.logger.DebugContext(ctx, command.String())
@@ -152,6 +168,11 @@ func (c *Client) execute(ctx context.Context, method string, path string, params
 		return nil, apiError
 	}
 
+	// a streamed body has been consumed and can not be sent again
+	if body != nil && intermediateBody == nil {
+		return nil, apiError
+	}
+
 	time.Sleep(time.Second * time.Duration(waitSeconds))
 
 	if body != nil {
@@ -180,13 +201,26 @@ func (c *Client) handleError(req *http.Request, res *http.Response) error {
 
 	var e common2.ErrorResponse
 	defer res.Body.Close()
-	err := json.NewDecoder(res.Body).Decode(&e)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, &e)
 	if err != nil {
 		return err
 	}
 
+	if e.Sys == nil {
+		return unexpectedResponseError(req, res, body)
+	}
+
 	apiError := common2.NewApiError(req, res, &e)
 
+	if common2.IsPlanLimitExceeded(&e) {
+		return common2.PlanLimitExceededError{apiError}
+	}
+
 	switch errType := e.Sys.ID; errType {
 	case "NotFound":
 		return common2.NotFoundError{apiError}
@@ -206,3 +240,19 @@ func (c *Client) handleError(req *http.Request, res *http.Response) error {
 		return e
 	}
 }
+
+// unexpectedResponseError keeps the body of responses without a Contentful error sys.
+// Rate limited responses are still reported as such, so they are retried.
+func unexpectedResponseError(req *http.Request, res *http.Response, body []byte) error {
+	if res.StatusCode == http.StatusTooManyRequests {
+		return common2.RateLimitExceededError{APIError: common2.NewApiError(req, res, &common2.ErrorResponse{
+			Message: "rate limit exceeded",
+		})}
+	}
+
+	return common2.UnexpectedResponseError{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       body,
+	}
+}
@@ -0,0 +1,74 @@
+package graphql
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+
+	internalcommon "github.com/labd/contentful-go/internal/common"
+	"github.com/labd/contentful-go/pkgs/client"
+	"github.com/labd/contentful-go/pkgs/util"
+	"github.com/labd/contentful-go/service"
+	"github.com/labd/contentful-go/service/graphql"
+)
+
+var _ graphql.SpaceIdClientBuilder = &Client{}
+
+type Client struct {
+	config internalcommon.ClientConfig
+}
+
+func New(config client.ClientConfig) (graphql.SpaceIdClientBuilder, error) {
+
+	httpClient := config.HTTPClient
+
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+
+	userAgent := config.UserAgent
+
+	if userAgent == nil {
+		userAgent = util.ToPointer(fmt.Sprintf("sdk contentful.go/%s", service.Version))
+	}
+
+	configUrl := config.URL
+
+	if configUrl == nil {
+		configUrl = util.ToPointer("https://graphql.contentful.com")
+	}
+
+	parsedURL, err := url.Parse(*configUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := config.Logger
+
+	if logger == nil {
+		logger = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+			Level: slog.LevelDebug,
+		}))
+	}
+
+	return &Client{
+		config: internalcommon.ClientConfig{
+			URL:         parsedURL,
+			HTTPClient:  httpClient,
+			Debug:       config.Debug,
+			UserAgent:   *userAgent,
+			ContentType: "application/json",
+			Token:       config.Token,
+			Logger:      logger,
+		},
+	}, nil
+}
+
+func (c *Client) WithSpaceId(spaceId string) graphql.SpaceIdClient {
+	return &SpaceIdClient{
+		client:  c,
+		spaceId: spaceId,
+	}
+}
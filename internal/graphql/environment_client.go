@@ -0,0 +1,136 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	internalcommon "github.com/labd/contentful-go/internal/common"
+	"github.com/labd/contentful-go/pkgs/common"
+	"github.com/labd/contentful-go/service/graphql"
+)
+
+var _ graphql.EnvironmentClient = &EnvironmentClient{}
+
+type EnvironmentClient struct {
+	client  *internalcommon.Client
+	config  internalcommon.ClientConfig
+	path    string
+	preview bool
+}
+
+type payload struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []graphql.Error `json:"errors"`
+}
+
+func (c *EnvironmentClient) Preview(previewToken string) graphql.EnvironmentClient {
+	config := c.config
+	config.Token = previewToken
+
+	return &EnvironmentClient{
+		client:  internalcommon.NewInternalClient(config),
+		config:  config,
+		path:    c.path,
+		preview: true,
+	}
+}
+
+func (c *EnvironmentClient) Query(ctx context.Context, request graphql.Request, result any) (*graphql.Response, error) {
+	if c.preview {
+		variables := map[string]any{"preview": true}
+		for key, value := range request.Variables {
+			variables[key] = value
+		}
+
+		request.Variables = variables
+	}
+
+	bytesArray, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.Post(ctx, c.path, nil, nil, bytes.NewReader(bytesArray))
+
+	if err != nil {
+		var unexpected common.UnexpectedResponseError
+		if !errors.As(err, &unexpected) {
+			return nil, err
+		}
+
+		var body payload
+		if json.Unmarshal(unexpected.Body, &body) != nil || len(body.Errors) == 0 {
+			return nil, err
+		}
+
+		return newResponse(unexpected.Header), newError(unexpected.StatusCode, unexpected.Header, body.Errors)
+	}
+
+	defer res.Body.Close()
+
+	response := newResponse(res.Header)
+
+	var body payload
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return response, err
+	}
+
+	if result != nil && len(body.Data) > 0 && string(body.Data) != "null" {
+		err = json.Unmarshal(body.Data, result)
+		if err != nil {
+			return response, err
+		}
+	}
+
+	if len(body.Errors) > 0 {
+		return response, newError(res.StatusCode, res.Header, body.Errors)
+	}
+
+	return response, nil
+}
+
+func newResponse(header http.Header) *graphql.Response {
+	cost, _ := strconv.Atoi(header.Get("X-Contentful-Graphql-Query-Cost"))
+
+	return &graphql.Response{
+		RequestID: header.Get("X-Contentful-Request-Id"),
+		Cost:      cost,
+	}
+}
+
+func newError(statusCode int, header http.Header, errs []graphql.Error) error {
+	responseError := graphql.ResponseError{
+		StatusCode: statusCode,
+		Errors:     errs,
+	}
+
+	for _, err := range errs {
+		if err.Code() != graphql.CodeTooComplexQuery {
+			continue
+		}
+
+		tooComplex := graphql.QueryTooComplexError{
+			ResponseError: responseError,
+		}
+
+		tooComplex.Cost, _ = strconv.Atoi(header.Get("X-Contentful-Graphql-Query-Cost"))
+
+		details := err.Extensions.Contentful.Details
+		if cost, ok := details["cost"].(float64); ok {
+			tooComplex.Cost = int(cost)
+		}
+
+		if maximumCost, ok := details["maximumCost"].(float64); ok {
+			tooComplex.MaximumCost = int(maximumCost)
+		}
+
+		return tooComplex
+	}
+
+	return responseError
+}
@@ -0,0 +1,23 @@
+package graphql
+
+import (
+	"fmt"
+
+	internalcommon "github.com/labd/contentful-go/internal/common"
+	"github.com/labd/contentful-go/service/graphql"
+)
+
+var _ graphql.SpaceIdClient = &SpaceIdClient{}
+
+type SpaceIdClient struct {
+	client  *Client
+	spaceId string
+}
+
+func (c *SpaceIdClient) WithEnvironment(environment string) graphql.EnvironmentClient {
+	return &EnvironmentClient{
+		client: internalcommon.NewInternalClient(c.client.config),
+		config: c.client.config,
+		path:   fmt.Sprintf("/content/v1/spaces/%s/environments/%s", c.spaceId, environment),
+	}
+}
@@ -0,0 +1,201 @@
+package graphql_tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/service/graphql"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type blogPosts struct {
+	BlogPostCollection struct {
+		Total int `json:"total"`
+		Items []struct {
+			Sys struct {
+				ID string `json:"id"`
+			} `json:"sys"`
+			Title string `json:"title"`
+		} `json:"items"`
+	} `json:"blogPostCollection"`
+}
+
+const blogPostsQuery = `query ($limit: Int, $preview: Boolean) { blogPostCollection(limit: $limit, preview: $preview) { total items { sys { id } title } } }`
+
+func TestEnvironmentClient_Query(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockGraphQLClient(t, assertions, testutil.ResponseData{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Contentful-Graphql-Query-Cost", "20")
+		w.Header().Set("X-Contentful-Request-Id", "a1b2c3")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintln(w, testutil.ReadTestData("graphql/query.json"))
+	}, func(r *http.Request) {
+		assertions.Equal(http.MethodPost, r.Method)
+		assertions.Equal("/content/v1/spaces/"+testutil.SpaceID+"/environments/master", r.URL.Path)
+		assertions.Equal("Bearer "+testutil.CDAToken, r.Header.Get("Authorization"))
+
+		var request graphql.Request
+		assertions.Nil(json.NewDecoder(r.Body).Decode(&request))
+		assertions.Equal(blogPostsQuery, request.Query)
+		assertions.Equal(map[string]any{"limit": float64(2)}, request.Variables)
+	})
+
+	defer ts.Close()
+
+	var result blogPosts
+	response, err := client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Query(context.Background(), graphql.Request{
+		Query:     blogPostsQuery,
+		Variables: map[string]any{"limit": 2},
+	}, &result)
+
+	assertions.Nil(err)
+	assertions.Equal(20, response.Cost)
+	assertions.Equal("a1b2c3", response.RequestID)
+	assertions.Equal(2, result.BlogPostCollection.Total)
+	assertions.Equal("post-1", result.BlogPostCollection.Items[0].Sys.ID)
+	assertions.Equal("Second post", result.BlogPostCollection.Items[1].Title)
+}
+
+func TestEnvironmentClient_Query_Preview(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockGraphQLClient(t, assertions, testutil.ResponseData{
+		StatusCode: http.StatusOK,
+		Path:       "graphql/query.json",
+	}, nil, func(r *http.Request) {
+		assertions.Equal("Bearer "+testutil.PreviewToken, r.Header.Get("Authorization"))
+
+		var request graphql.Request
+		assertions.Nil(json.NewDecoder(r.Body).Decode(&request))
+		assertions.Equal(map[string]any{"limit": float64(2), "preview": true}, request.Variables)
+	})
+
+	defer ts.Close()
+
+	var result blogPosts
+	_, err = client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Preview(testutil.PreviewToken).Query(context.Background(), graphql.Request{
+		Query:     blogPostsQuery,
+		Variables: map[string]any{"limit": 2},
+	}, &result)
+
+	assertions.Nil(err)
+	assertions.Equal(2, result.BlogPostCollection.Total)
+}
+
+func TestEnvironmentClient_Query_PartialData(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockGraphQLClient(t, assertions, testutil.ResponseData{
+		StatusCode: http.StatusOK,
+		Path:       "graphql/partial.json",
+	}, nil, func(r *http.Request) {})
+
+	defer ts.Close()
+
+	var result struct {
+		BlogPost struct {
+			Title string `json:"title"`
+		} `json:"blogPost"`
+	}
+
+	_, err = client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Query(context.Background(), graphql.Request{
+		Query: `{ blogPost(id: "blog-post") { title author { name } } }`,
+	}, &result)
+
+	assertions.Equal("Hello world", result.BlogPost.Title)
+
+	var responseError graphql.ResponseError
+	assertions.ErrorAs(err, &responseError)
+	assertions.Equal(http.StatusOK, responseError.StatusCode)
+	assertions.True(responseError.HasCode(graphql.CodeUnresolvableLink))
+	assertions.Equal([]any{"blogPost", "author"}, responseError.Errors[0].Path)
+	assertions.Equal(graphql.Location{Line: 1, Column: 50}, responseError.Errors[0].Locations[0])
+	assertions.Equal("b9e6f1d2", responseError.Errors[0].Extensions.Contentful.RequestID)
+}
+
+func TestEnvironmentClient_Query_TooComplex(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockGraphQLClient(t, assertions, testutil.ResponseData{
+		StatusCode: http.StatusBadRequest,
+		Path:       "graphql/too_complex.json",
+	}, nil, func(r *http.Request) {})
+
+	defer ts.Close()
+
+	var result blogPosts
+	_, err = client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Query(context.Background(), graphql.Request{
+		Query: blogPostsQuery,
+	}, &result)
+
+	var tooComplex graphql.QueryTooComplexError
+	assertions.ErrorAs(err, &tooComplex)
+	assertions.Equal(12500, tooComplex.Cost)
+	assertions.Equal(11000, tooComplex.MaximumCost)
+	assertions.Equal(http.StatusBadRequest, tooComplex.StatusCode)
+	assertions.True(tooComplex.HasCode(graphql.CodeTooComplexQuery))
+}
+
+func TestEnvironmentClient_Query_AccessTokenInvalid(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	client, ts := testutil.MockGraphQLClient(t, assertions, testutil.ResponseData{
+		StatusCode: http.StatusUnauthorized,
+		Path:       "graphql/access_token_invalid.json",
+	}, nil, func(r *http.Request) {})
+
+	defer ts.Close()
+
+	_, err = client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Query(context.Background(), graphql.Request{
+		Query: blogPostsQuery,
+	}, nil)
+
+	var responseError graphql.ResponseError
+	assertions.ErrorAs(err, &responseError)
+	assertions.Equal(http.StatusUnauthorized, responseError.StatusCode)
+	assertions.True(responseError.HasCode(graphql.CodeAccessTokenInvalid))
+	assertions.Equal("graphql: ACCESS_TOKEN_INVALID: The access token you sent could not be found or is invalid.", err.Error())
+}
+
+func TestEnvironmentClient_Query_RateLimit(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	requests := 0
+
+	client, ts := testutil.MockGraphQLClient(t, assertions, testutil.ResponseData{}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if requests == 1 {
+			w.Header().Set("X-Contentful-Ratelimit-Reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = fmt.Fprintln(w, `{"errors": [{"message": "rate limit exceeded"}]}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintln(w, testutil.ReadTestData("graphql/query.json"))
+	}, func(r *http.Request) {})
+
+	defer ts.Close()
+
+	var result blogPosts
+	_, err = client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Query(context.Background(), graphql.Request{
+		Query: blogPostsQuery,
+	}, &result)
+
+	assertions.Nil(err)
+	assertions.Equal(2, requests)
+	assertions.Equal(2, result.BlogPostCollection.Total)
+}
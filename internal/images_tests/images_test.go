@@ -0,0 +1,170 @@
+package images_tests
+
+import (
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/images"
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const imageURL = "https://images.ctfassets.net/space/asset/hash/image.jpg"
+
+func TestBuilder_URL(t *testing.T) {
+	assertions := assert.New(t)
+
+	result, err := images.New(imageURL).
+		Size(400, 300).
+		Fit(images.FitThumb).
+		Focus(images.FocusFace).
+		Progressive().
+		Quality(80).
+		URL()
+
+	assertions.Nil(err)
+	assertions.Equal(imageURL+"?f=face&fit=thumb&fl=progressive&fm=jpg&h=300&q=80&w=400", result)
+}
+
+func TestBuilder_URL_NoOptions(t *testing.T) {
+	assertions := assert.New(t)
+
+	result, err := images.New("//images.ctfassets.net/space/asset/hash/image.jpg").URL()
+
+	assertions.Nil(err)
+	assertions.Equal(imageURL, result)
+}
+
+func TestBuilder_URL_RadiusAndBackground(t *testing.T) {
+	assertions := assert.New(t)
+
+	result, err := images.New(imageURL).Format(images.FormatAVIF).MaxRadius().Background("#FF00aa").URL()
+
+	assertions.Nil(err)
+	assertions.Equal(imageURL+"?bg=rgb%3Aff00aa&fm=avif&r=max", result)
+
+	result, err = images.New(imageURL).Width(200).Fit(images.FitPad).Background("ffffff").Radius(20).URL()
+
+	assertions.Nil(err)
+	assertions.Equal(imageURL+"?bg=rgb%3Affffff&fit=pad&r=20&w=200", result)
+}
+
+func TestBuilder_Validate(t *testing.T) {
+	original := images.New(imageURL)
+
+	cases := map[string]images.Builder{
+		"width too large":            original.Width(images.MaxDimension + 1),
+		"negative height":            original.Height(-1),
+		"unknown format":             original.Format("bmp"),
+		"progressive webp":           original.Progressive().Format(images.FormatWebP),
+		"png8 jpg":                   original.PNG8().Format(images.FormatJPG),
+		"quality out of range":       original.Quality(101),
+		"quality for png":            original.Format(images.FormatPNG).Quality(50),
+		"quality for gif":            original.Format(images.FormatGIF).Quality(50),
+		"unknown fit":                original.Width(100).Fit("stretch"),
+		"fit without dimensions":     original.Fit(images.FitFill),
+		"unknown focus":              original.Width(100).Fit(images.FitFill).Focus("middle"),
+		"focus without cropping fit": original.Width(100).Fit(images.FitScale).Focus(images.FocusFace),
+		"negative radius":            original.Radius(-5),
+		"invalid background":         original.Width(100).Fit(images.FitPad).Background("red"),
+		"background without padding": original.Background("#ffffff"),
+		"missing url":                images.New("").Width(100),
+	}
+
+	for name, builder := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := builder.URL()
+			assert.ErrorIs(t, err, images.ErrInvalidTransformation)
+		})
+	}
+}
+
+func TestBuilder_Immutable(t *testing.T) {
+	assertions := assert.New(t)
+
+	base := images.New(imageURL).Format(images.FormatWebP)
+	small := base.Width(100)
+	large := base.Width(1000)
+
+	smallURL, err := small.URL()
+	assertions.Nil(err)
+	assertions.Equal(imageURL+"?fm=webp&w=100", smallURL)
+
+	largeURL, err := large.URL()
+	assertions.Nil(err)
+	assertions.Equal(imageURL+"?fm=webp&w=1000", largeURL)
+
+	baseURL, err := base.URL()
+	assertions.Nil(err)
+	assertions.Equal(imageURL+"?fm=webp", baseURL)
+}
+
+func TestFromAsset(t *testing.T) {
+	assertions := assert.New(t)
+
+	var asset model.Asset
+	err := testutil.ModelFromTestData("asset/get.json", &asset)
+	assertions.Nil(err)
+
+	builder, err := images.FromAsset(&asset, "en-US")
+	assertions.Nil(err)
+
+	// the original is a png, so quality requires png8 or another format
+	_, err = builder.Quality(50).URL()
+	assertions.ErrorIs(err, images.ErrInvalidTransformation)
+
+	result, err := builder.Format(images.FormatWebP).Quality(50).URL()
+	assertions.Nil(err)
+	assertions.Equal("https://images.flinkly.com/222ru4k10hm8/3HNzx9gvJScKku4UmcekYw/997663077456077dde5b5be9bd3c1386/d3b8dad44e5066cfb805e2357469ee64.png?fm=webp&q=50", result)
+
+	_, err = images.FromAsset(&asset, "fr")
+	assertions.NotNil(err)
+
+	asset.Fields.File["en-US"].ContentType = "application/pdf"
+	_, err = images.FromAsset(&asset, "en-US")
+	assertions.ErrorIs(err, images.ErrInvalidTransformation)
+}
+
+func TestBuilder_SrcSet(t *testing.T) {
+	assertions := assert.New(t)
+
+	result, err := images.New(imageURL).Size(400, 200).Fit(images.FitFill).SrcSet(800, 400, 400)
+
+	assertions.Nil(err)
+	assertions.Equal(
+		imageURL+"?fit=fill&h=200&w=400 400w, "+imageURL+"?fit=fill&h=400&w=800 800w",
+		result,
+	)
+
+	_, err = images.New(imageURL).SrcSet()
+	assertions.ErrorIs(err, images.ErrInvalidTransformation)
+
+	_, err = images.New(imageURL).SrcSet(0, 400)
+	assertions.ErrorIs(err, images.ErrInvalidTransformation)
+
+	_, err = images.New(imageURL).SrcSet(400, -200)
+	assertions.ErrorIs(err, images.ErrInvalidTransformation)
+}
+
+func TestBuilder_SrcSet_FromAsset(t *testing.T) {
+	assertions := assert.New(t)
+
+	var asset model.Asset
+	err := testutil.ModelFromTestData("asset/get.json", &asset)
+	assertions.Nil(err)
+
+	builder, err := images.FromAsset(&asset, "en-US")
+	assertions.Nil(err)
+
+	// the original is 206 pixels wide, larger widths are not upscaled
+	result, err := builder.Format(images.FormatWebP).SrcSet(100, 200, 400)
+	assertions.Nil(err)
+	assertions.Contains(result, "fm=webp&w=100 100w, ")
+	assertions.Contains(result, "fm=webp&w=200 200w")
+	assertions.NotContains(result, "400w")
+
+	result, err = builder.SrcSet(400, 800)
+	assertions.Nil(err)
+	assertions.Contains(result, "?w=206 206w")
+}
@@ -0,0 +1,65 @@
+package model_tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const richTextEntry = `{
+  "sys": {"id": "post", "type": "Entry"},
+  "fields": {
+    "body": {
+      "en-US": {
+        "nodeType": "document",
+        "data": {},
+        "content": [
+          {"nodeType": "paragraph", "data": {}, "content": [{"nodeType": "text", "value": "hello", "marks": [], "data": {}}]},
+          {"nodeType": "embedded-entry-block", "data": {"target": {"sys": {"type": "Link", "linkType": "Entry", "id": "nyancat"}}}, "content": []},
+          {"nodeType": "embedded-asset-block", "data": {"target": {"sys": {"type": "Link", "linkType": "Asset", "id": "image"}}}, "content": []}
+        ]
+      }
+    },
+    "author": {"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "nyancat"}}},
+    "tags": {"en-US": ["cats", "memes"]}
+  }
+}`
+
+func TestEntryLinks(t *testing.T) {
+	assertions := assert.New(t)
+
+	var entry model.Entry
+	err := json.Unmarshal([]byte(richTextEntry), &entry)
+	assertions.Nil(err)
+
+	assertions.Equal([]model.FieldLink{
+		{Field: "author", Locale: "en-US", LinkType: "Entry", ID: "nyancat"},
+		{Field: "body", Locale: "en-US", LinkType: "Entry", ID: "nyancat"},
+		{Field: "body", Locale: "en-US", LinkType: "Asset", ID: "image"},
+	}, entry.Links())
+	assertions.True(entry.LinksTo(model.LinkTypeAsset, "image"))
+	assertions.False(entry.LinksTo(model.LinkTypeEntry, "image"))
+}
+
+func TestEntryRemoveLinks(t *testing.T) {
+	assertions := assert.New(t)
+
+	var entry model.Entry
+	err := json.Unmarshal([]byte(richTextEntry), &entry)
+	assertions.Nil(err)
+
+	assertions.True(entry.RemoveLinks(model.LinkTypeEntry, "nyancat"))
+	assertions.False(entry.RemoveLinks(model.LinkTypeEntry, "nyancat"))
+
+	assertions.NotContains(entry.Fields, "author")
+	assertions.Contains(entry.Fields, "tags")
+	assertions.Equal([]model.FieldLink{
+		{Field: "body", Locale: "en-US", LinkType: "Asset", ID: "image"},
+	}, entry.Links())
+
+	content := entry.Fields["body"].(map[string]any)["en-US"].(map[string]any)["content"].([]any)
+	assertions.Len(content, 2)
+}
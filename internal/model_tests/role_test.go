@@ -0,0 +1,89 @@
+package model_tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyBuilder(t *testing.T) {
+	assertions := assert.New(t)
+
+	policy := model.DenyPolicy().
+		Assets().
+		CreatedByCurrentUser().
+		Tagged("legal").
+		Fields("file", "title").
+		Locales("en-US", "de-DE").
+		Build()
+
+	assertions.Equal(model.PolicyEffectDeny, policy.Effect)
+	assertions.True(policy.Actions.IsAll())
+
+	data, err := json.Marshal(policy)
+	assertions.Nil(err)
+	assertions.JSONEq(`{
+		"effect": "deny",
+		"actions": "all",
+		"constraint": {"and": [
+			{"equals": [{"doc": "sys.type"}, "Asset"]},
+			{"equals": [{"doc": "sys.createdBy.sys.id"}, "User.current()"]},
+			{"in": [{"doc": "metadata.tags.sys.id"}, ["legal"]]},
+			{"paths": [
+				{"doc": "fields.file.en-US"},
+				{"doc": "fields.file.de-DE"},
+				{"doc": "fields.title.en-US"},
+				{"doc": "fields.title.de-DE"}
+			]}
+		]}
+	}`, string(data))
+
+	var decoded model.RolePolicy
+	err = json.Unmarshal(data, &decoded)
+	assertions.Nil(err)
+	assertions.Equal(policy, &decoded)
+}
+
+func TestPolicyBuilder_Where(t *testing.T) {
+	assertions := assert.New(t)
+
+	policy := model.AllowPolicy(model.RoleActionRead).
+		Entries().
+		IDs("a", "b").
+		Where(model.ConstraintNot{Constraint: model.ConstraintEquals{Doc: model.ConstraintDocContentType, Value: "settings"}}).
+		Fields("title").
+		Build()
+
+	assertions.Equal(model.ConstraintAnd{
+		model.ConstraintEquals{Doc: "sys.type", Value: "Entry"},
+		model.ConstraintIn{Doc: "sys.id", Values: []string{"a", "b"}},
+		model.ConstraintNot{Constraint: model.ConstraintEquals{Doc: "sys.contentType.sys.id", Value: "settings"}},
+		model.ConstraintPaths{"fields.title.%"},
+	}, policy.Constraint)
+}
+
+func TestParseConstraint_Raw(t *testing.T) {
+	assertions := assert.New(t)
+
+	for _, data := range []string{
+		`{"exists": [{"doc": "fields.title"}]}`,
+		`{"equals": [{"doc": "sys.version"}, 1]}`,
+		`{"equals": [{"doc": "sys.id"}, null]}`,
+		`{"in": [{"doc": "sys.id"}, "a"]}`,
+		`{"equals": [{"doc": "sys.id"}, "a"], "in": [{"doc": "sys.id"}, ["a"]]}`,
+	} {
+		constraint, err := model.ParseConstraint(json.RawMessage(data))
+		assertions.Nil(err)
+		assertions.IsType(model.ConstraintRaw{}, constraint, data)
+
+		marshaled, err := json.Marshal(constraint)
+		assertions.Nil(err)
+		assertions.JSONEq(data, string(marshaled))
+	}
+
+	_, err := model.ParseConstraint(json.RawMessage(`{"and": "invalid"}`))
+	assertions.NotNil(err)
+}
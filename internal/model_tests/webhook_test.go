@@ -0,0 +1,131 @@
+package model_tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/labd/contentful-go/pkgs/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookTopic_Validate(t *testing.T) {
+	valid := []model.WebhookTopic{
+		model.WebhookTopicAll,
+		"Entry.publish",
+		"Entry.*",
+		"*.publish",
+		"ContentType.save",
+		"ScheduledAction.execute",
+	}
+
+	for _, topic := range valid {
+		assert.Nil(t, topic.Validate(), topic)
+	}
+
+	invalid := []model.WebhookTopic{
+		"Entry.publised",
+		"Entries.publish",
+		"Entry",
+		"Entry.",
+		".publish",
+		"ContentManagement.Entry.publish",
+		"ContentType.archive",
+		"*.publised",
+	}
+
+	for _, topic := range invalid {
+		assert.ErrorIs(t, topic.Validate(), model.ErrInvalidWebhookTopic, topic)
+	}
+}
+
+func TestWebhookTopicsBuilder(t *testing.T) {
+	assertions := assert.New(t)
+
+	topic := model.NewWebhookTopic(model.WebhookTypeEntry, model.WebhookActionPublish)
+	assertions.Equal(model.WebhookTopic("Entry.publish"), topic)
+	assertions.Equal(model.WebhookTypeEntry, topic.Type())
+	assertions.Equal(model.WebhookActionPublish, topic.Action())
+
+	topics, err := model.NewWebhookTopicsBuilder().
+		Add(model.WebhookTypeEntry, model.WebhookActionPublish, model.WebhookActionUnpublish).
+		Add(model.WebhookTypeAsset).
+		Add(model.WebhookTypeAll, model.WebhookActionDelete).
+		Topic("Entry.publish").
+		Build()
+
+	assertions.Nil(err)
+	assertions.Equal([]model.WebhookTopic{"Entry.publish", "Entry.unpublish", "Asset.*", "*.delete"}, topics)
+
+	_, err = model.NewWebhookTopicsBuilder().
+		Add(model.WebhookTypeComment, model.WebhookActionPublish).
+		Topic("Entry.publised").
+		Build()
+
+	assertions.ErrorIs(err, model.ErrInvalidWebhookTopic)
+	assertions.Contains(err.Error(), "Comment has no action publish")
+	assertions.Contains(err.Error(), "Entry has no action publised")
+}
+
+func TestWebhookFiltersBuilder(t *testing.T) {
+	assertions := assert.New(t)
+
+	filters, err := model.NewWebhookFiltersBuilder().
+		Environment("master").
+		ContentType("blogPost", "page").
+		NotEquals(model.WebhookFilterDocCreatedBy, "bot").
+		NotIn(model.WebhookFilterDocContentType, "settings").
+		Regexp(model.WebhookFilterDocID, "^blog-").
+		Build()
+
+	assertions.Nil(err)
+
+	data, err := json.Marshal(filters)
+	assertions.Nil(err)
+	assertions.JSONEq(`[
+		{"equals": [{"doc": "sys.environment.sys.id"}, "master"]},
+		{"in": [{"doc": "sys.contentType.sys.id"}, ["blogPost", "page"]]},
+		{"not": {"equals": [{"doc": "sys.createdBy.sys.id"}, "bot"]}},
+		{"not": {"in": [{"doc": "sys.contentType.sys.id"}, ["settings"]]}},
+		{"regexp": [{"doc": "sys.id"}, {"pattern": "^blog-"}]}
+	]`, string(data))
+
+	var decoded []*model.WebhookFilter
+	err = json.Unmarshal(data, &decoded)
+	assertions.Nil(err)
+	assertions.Equal(filters, decoded)
+}
+
+func TestWebhookFiltersBuilder_Invalid(t *testing.T) {
+	cases := map[string]*model.WebhookFiltersBuilder{
+		"unknown path":    model.NewWebhookFiltersBuilder().Equals("fields.title", "Hello"),
+		"typo in path":    model.NewWebhookFiltersBuilder().Equals("sys.contenttype.sys.id", "page"),
+		"empty value":     model.NewWebhookFiltersBuilder().Equals(model.WebhookFilterDocID, ""),
+		"empty values":    model.NewWebhookFiltersBuilder().In(model.WebhookFilterDocID),
+		"invalid pattern": model.NewWebhookFiltersBuilder().Regexp(model.WebhookFilterDocID, "(blog"),
+	}
+
+	for name, builder := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := builder.Build()
+			assert.ErrorIs(t, err, model.ErrInvalidWebhookFilter)
+		})
+	}
+}
+
+func TestWebhook_Validate(t *testing.T) {
+	assertions := assert.New(t)
+
+	webhook := &model.Webhook{
+		Topics:  []model.WebhookTopic{"Entry.publish"},
+		Filters: []*model.WebhookFilter{{Operator: model.WebhookFilterEquals, Doc: model.WebhookFilterDocEnvironment, Value: "master"}},
+	}
+	assertions.Nil(webhook.Validate())
+
+	webhook.Topics = append(webhook.Topics, "Entry.publised")
+	webhook.Filters = append(webhook.Filters, &model.WebhookFilter{Operator: model.WebhookFilterEquals, Doc: "sys.env"})
+
+	err := webhook.Validate()
+	assertions.ErrorIs(err, model.ErrInvalidWebhookTopic)
+	assertions.ErrorIs(err, model.ErrInvalidWebhookFilter)
+}
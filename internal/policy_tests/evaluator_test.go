@@ -0,0 +1,156 @@
+package policy_tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/pkgs/policy"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func translator(t *testing.T) *policy.Evaluator {
+	var role *model.Role
+	err := testutil.ModelFromTestData("/role/get.json", &role)
+	assert.Nil(t, err)
+
+	return policy.New(role, &policy.Options{UserID: "translator"})
+}
+
+func TestEvaluator_Entries(t *testing.T) {
+	evaluator := translator(t)
+
+	blogPost := policy.NewEntry("hello", "blogPost")
+
+	cases := map[string]struct {
+		action   string
+		entity   policy.Entity
+		expected bool
+	}{
+		"read entry":                   {model.RoleActionRead, blogPost, true},
+		"update entry":                 {model.RoleActionUpdate, blogPost, true},
+		"update field in locale":       {model.RoleActionUpdate, blogPost.Field("title", "de-DE"), true},
+		"update field in other locale": {model.RoleActionUpdate, blogPost.Field("title", "en-US"), false},
+		"publish entry":                {model.RoleActionPublish, blogPost, false},
+		"read other content type":      {model.RoleActionRead, policy.NewEntry("home", "page"), false},
+		"read asset of other user":     {model.RoleActionRead, policy.NewAsset("logo").CreatedBy("designer"), false},
+		"read own asset":               {model.RoleActionRead, policy.NewAsset("logo").CreatedBy("translator"), true},
+		"publish own asset":            {model.RoleActionPublish, policy.NewAsset("logo").CreatedBy("translator"), true},
+		"publish own legal asset":      {model.RoleActionPublish, policy.NewAsset("terms").CreatedBy("translator").Tags("legal"), false},
+		"delete own legal asset":       {model.RoleActionDelete, policy.NewAsset("terms").CreatedBy("translator").Tags("legal"), true},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, evaluator.Can(test.action, test.entity))
+		})
+	}
+}
+
+func TestEvaluator_Models(t *testing.T) {
+	assertions := assert.New(t)
+
+	evaluator := translator(t)
+
+	var entry *model.Entry
+	err := testutil.ModelFromTestData("/entry/get.json", &entry)
+	assertions.Nil(err)
+
+	// the fixture is of another content type
+	assertions.False(evaluator.Can(model.RoleActionRead, policy.Entry(entry)))
+
+	entry.Sys.ContentType.Sys.ID = "blogPost"
+	assertions.True(evaluator.Can(model.RoleActionRead, policy.Entry(entry)))
+	assertions.True(evaluator.Can(model.RoleActionUpdate, policy.Entry(entry).Field("body", "de-DE")))
+
+	var asset *model.Asset
+	err = testutil.ModelFromTestData("/asset/get.json", &asset)
+	assertions.Nil(err)
+
+	assertions.False(evaluator.Can(model.RoleActionRead, policy.Asset(asset)))
+	assertions.True(evaluator.Can(model.RoleActionRead, policy.Asset(asset).CreatedBy("translator")))
+}
+
+func TestEvaluator_DeniedPaths(t *testing.T) {
+	assertions := assert.New(t)
+
+	evaluator := policy.New(&model.Role{
+		Policies: []*model.RolePolicy{
+			model.AllowPolicy().Entries().Build(),
+			model.DenyPolicy(model.RoleActionUpdate).Entries().Fields("slug").Build(),
+		},
+	}, nil)
+
+	page := policy.NewEntry("home", "page")
+
+	assertions.True(evaluator.Can(model.RoleActionUpdate, page))
+	assertions.True(evaluator.Can(model.RoleActionUpdate, page.Field("title", "en-US")))
+	assertions.False(evaluator.Can(model.RoleActionUpdate, page.Field("slug", "en-US")))
+	assertions.True(evaluator.Can(model.RoleActionRead, page.Field("slug", "en-US")))
+	assertions.False(evaluator.Can(model.RoleActionRead, policy.NewAsset("logo")))
+}
+
+func TestEvaluator_UnknownConstraints(t *testing.T) {
+	assertions := assert.New(t)
+
+	parse := func(data string) *policy.Evaluator {
+		var role *model.Role
+		err := json.Unmarshal([]byte(data), &role)
+		assertions.Nil(err)
+
+		return policy.New(role, nil)
+	}
+
+	entry := policy.NewEntry("home", "page")
+
+	// a negated unknown constraint is still unknown, so it neither allows nor denies
+	notExists := parse(`{"policies": [{"effect": "allow", "actions": ["read"], "constraint": {"not": {"exists": [{"doc": "fields.title"}]}}}]}`)
+	assertions.False(notExists.Can(model.RoleActionRead, entry))
+
+	deniedNotExists := parse(`{"policies": [
+		{"effect": "allow", "actions": ["read"], "constraint": {"equals": [{"doc": "sys.type"}, "Entry"]}},
+		{"effect": "deny", "actions": ["read"], "constraint": {"not": {"exists": [{"doc": "fields.title"}]}}}
+	]}`)
+	assertions.True(deniedNotExists.Can(model.RoleActionRead, entry))
+
+	// the outcome of and and or does not depend on an unknown constraint when another item decides it
+	combined := parse(`{"policies": [
+		{"effect": "allow", "actions": ["read"], "constraint": {"or": [{"exists": [{"doc": "fields.title"}]}, {"equals": [{"doc": "sys.type"}, "Entry"]}]}},
+		{"effect": "deny", "actions": ["read"], "constraint": {"not": {"and": [{"exists": [{"doc": "fields.title"}]}, {"equals": [{"doc": "sys.type"}, "Asset"]}]}}}
+	]}`)
+	assertions.False(combined.Can(model.RoleActionRead, entry))
+}
+
+func TestEvaluator_Permissions(t *testing.T) {
+	assertions := assert.New(t)
+
+	evaluator := translator(t)
+
+	assertions.True(evaluator.HasPermission(model.PermissionContentModel, model.RoleActionRead))
+	assertions.False(evaluator.HasPermission(model.PermissionContentModel, model.RoleActionManage))
+	assertions.True(evaluator.HasPermission(model.PermissionSettings, model.RoleActionManage))
+	assertions.False(evaluator.HasPermission(model.PermissionTags, model.RoleActionManage))
+	assertions.False(evaluator.HasPermission("Unknown", model.RoleActionRead))
+}
+
+func TestEvaluator_Matrix(t *testing.T) {
+	assertions := assert.New(t)
+
+	evaluator := translator(t)
+
+	actions := []string{model.RoleActionRead, model.RoleActionUpdate, model.RoleActionPublish}
+
+	matrix := evaluator.Matrix(actions, policy.NewEntry("hello", "blogPost"), policy.NewEntry("home", "page"))
+	assertions.Len(matrix.Rows, 2)
+	assertions.True(matrix.Rows[0].Allowed[model.RoleActionUpdate])
+	assertions.False(matrix.Rows[1].Allowed[model.RoleActionRead])
+
+	fields := evaluator.FieldMatrix([]string{model.RoleActionUpdate}, policy.NewEntry("hello", "blogPost"), []string{"title"}, []string{"en-US", "de-DE"})
+
+	assertions.Equal(""+
+		"entity                                   update\n"+
+		"Entry blogPost/hello fields.title.en-US  -\n"+
+		"Entry blogPost/hello fields.title.de-DE  x\n", fields.String())
+}
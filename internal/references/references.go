@@ -0,0 +1,62 @@
+// Package references resolves the links between entries and assets, for both the
+// management and the delivery API
+package references
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/labd/contentful-go/pkgs/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	common2 "github.com/labd/contentful-go/service/common"
+)
+
+// MaxReferenceDepth is the maximum include depth supported by the references endpoint
+const MaxReferenceDepth = 10
+
+// GetReferences fetches the entry with the given id and all entries and assets it references.
+// The query may be nil and is used for additional parameters like the locale.
+func GetReferences(ctx context.Context, client common2.RestClient, basePath string, entryId string, depth uint16, query url.Values) (*model.ReferenceGraph, error) {
+	if depth > MaxReferenceDepth {
+		return nil, fmt.Errorf("reference depth should be between 0 and %d", MaxReferenceDepth)
+	}
+
+	if query == nil {
+		query = url.Values{}
+	}
+
+	query.Set("include", strconv.Itoa(int(depth)))
+
+	res, err := client.Get(ctx, fmt.Sprintf("%s/%s/references", basePath, entryId), query, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var references model.EntryReferences
+
+	defer res.Body.Close()
+	err = json.NewDecoder(res.Body).Decode(&references)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.NewReferenceGraph(entryId, &references), nil
+}
+
+// FilterLinksTo narrows the query down to entries linking to the entity with the given link type and id
+func FilterLinksTo(query *common.Query, linkType string, id string) error {
+	switch linkType {
+	case model.LinkTypeEntry:
+		query.Equal("links_to_entry", id)
+	case model.LinkTypeAsset:
+		query.Equal("links_to_asset", id)
+	default:
+		return fmt.Errorf("unsupported link type %q", linkType)
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package replica_tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/pkgs/replica"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// syncHandler serves the initial sync, and the delta once deltas are enabled
+func syncHandler(deltas *bool, mutex *sync.Mutex) testutil.HTTPHandler {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		fixture := ""
+
+		switch r.URL.Query().Get("sync_token") {
+		case "":
+			fixture = "replica/initial_page_1.json"
+		case "page2":
+			fixture = "replica/initial_page_2.json"
+		case "delta1":
+			if *deltas {
+				fixture = "replica/delta.json"
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+
+		if fixture == "" {
+			_, _ = fmt.Fprintln(w, `{"sys": {"type": "Array"}, "items": [], "nextSyncUrl": "https://cdn.contentful.com/spaces/id1/environments/master/sync?sync_token=`+r.URL.Query().Get("sync_token")+`"}`)
+			return
+		}
+
+		_, _ = fmt.Fprintln(w, testutil.ReadTestData(fixture))
+	}
+}
+
+func newStore(t *testing.T, assertions *assert.Assertions, deltas *bool, mutex *sync.Mutex) (*replica.Store, func()) {
+	client, ts := testutil.MockCDAClient(t, assertions, testutil.ResponseData{}, syncHandler(deltas, mutex), func(r *http.Request) {})
+
+	store := replica.New(client.WithSpaceId(testutil.SpaceID).WithEnvironment("master").Sync(), nil)
+
+	return store, ts.Close
+}
+
+func ids(entries []*model.Entry) []string {
+	result := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry.Sys.ID)
+	}
+
+	return result
+}
+
+func TestStore_Sync(t *testing.T) {
+	assertions := assert.New(t)
+
+	deltas := false
+	store, closeServer := newStore(t, assertions, &deltas, &sync.Mutex{})
+	defer closeServer()
+
+	err := store.Sync(context.Background())
+	assertions.Nil(err)
+
+	entry, ok := store.Entry("nyancat")
+	assertions.True(ok)
+	assertions.Equal("Nyan Cat", entry.Fields["name"].(map[string]any)["en-US"])
+
+	asset, ok := store.Asset("nyancatimage")
+	assertions.True(ok)
+	assertions.Equal("Nyan Cat", asset.Fields.Title["en-US"])
+
+	graph, ok := store.Graph("happycat")
+	assertions.True(ok)
+	assertions.Equal("nyancat", graph.Entries["nyancat"].Sys.ID)
+	assertions.Equal("nyancatimage", graph.Assets["nyancatimage"].Sys.ID)
+	assertions.Empty(graph.Unresolved())
+
+	resolved := store.Resolve(model.FieldLink{LinkType: model.LinkTypeAsset, ID: "nyancatimage"})
+	assertions.Equal(asset, resolved)
+
+	deltas = true
+	err = store.Sync(context.Background())
+	assertions.Nil(err)
+
+	entry, ok = store.Entry("nyancat")
+	assertions.True(ok)
+	assertions.Equal("Nyan Cat Deluxe", entry.Fields["name"].(map[string]any)["en-US"])
+
+	_, ok = store.Entry("happycat")
+	assertions.False(ok)
+
+	_, ok = store.Asset("nyancatimage")
+	assertions.False(ok)
+
+	assertions.Nil(store.Resolve(model.FieldLink{LinkType: model.LinkTypeEntry, ID: "happycat"}))
+
+	entries, err := store.Query(common.NewQuery().ContentType("cat"))
+	assertions.Nil(err)
+	assertions.Equal([]string{"nyancat"}, ids(entries))
+}
+
+func TestStore_Query(t *testing.T) {
+	assertions := assert.New(t)
+
+	deltas := false
+	store, closeServer := newStore(t, assertions, &deltas, &sync.Mutex{})
+	defer closeServer()
+
+	err := store.Sync(context.Background())
+	assertions.Nil(err)
+
+	cases := map[string]struct {
+		query    *common.Query
+		expected []string
+	}{
+		"all, by default ordered by last update": {
+			query:    nil,
+			expected: []string{"happycat", "nyancat"},
+		},
+		"equal": {
+			query:    common.NewQuery().ContentType("cat").Equal("fields.name", "Happy Cat"),
+			expected: []string{"happycat"},
+		},
+		"equal on sys": {
+			query:    common.NewQuery().Equal("sys.id", "nyancat"),
+			expected: []string{"nyancat"},
+		},
+		"equal on link": {
+			query:    common.NewQuery().Equal("fields.bestFriend.sys.id", "nyancat"),
+			expected: []string{"happycat"},
+		},
+		"equal on number": {
+			query:    common.NewQuery().Equal("fields.lives", 9),
+			expected: []string{"nyancat"},
+		},
+		"in": {
+			query:    common.NewQuery().In("sys.id", []string{"nyancat", "grumpycat"}),
+			expected: []string{"nyancat"},
+		},
+		"exists": {
+			query:    common.NewQuery().Exists("fields.bestFriend"),
+			expected: []string{"happycat"},
+		},
+		"not exists": {
+			query:    common.NewQuery().NotExists("fields.bestFriend"),
+			expected: []string{"nyancat"},
+		},
+		"number range": {
+			query:    common.NewQuery().GreaterThan("fields.lives", 7).LessThanOrEqual("fields.lives", 9),
+			expected: []string{"nyancat"},
+		},
+		"date range": {
+			query:    common.NewQuery().GreaterThanOrEqual("sys.updatedAt", time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)),
+			expected: []string{"happycat"},
+		},
+		"order": {
+			query:    common.NewQuery().Order("fields.lives", false),
+			expected: []string{"happycat", "nyancat"},
+		},
+		"reverse order": {
+			query:    common.NewQuery().Order("fields.name", true),
+			expected: []string{"nyancat", "happycat"},
+		},
+		"limit": {
+			query:    common.NewQuery().Order("fields.name", false).Limit(1),
+			expected: []string{"happycat"},
+		},
+		"skip": {
+			query:    common.NewQuery().Order("fields.name", false).Skip(1),
+			expected: []string{"nyancat"},
+		},
+		"locale": {
+			query:    common.NewQuery().Locale("de").Equal("fields.name", "Nyan Katze"),
+			expected: []string{"nyancat"},
+		},
+		"locale falls back to default locale": {
+			query:    common.NewQuery().Locale("de").Equal("fields.name", "Happy Cat"),
+			expected: []string{"happycat"},
+		},
+		"unknown content type": {
+			query:    common.NewQuery().ContentType("dog"),
+			expected: []string{},
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			entries, err := store.Query(test.query)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, ids(entries))
+		})
+	}
+}
+
+func TestStore_Query_Unsupported(t *testing.T) {
+	assertions := assert.New(t)
+
+	deltas := false
+	store, closeServer := newStore(t, assertions, &deltas, &sync.Mutex{})
+	defer closeServer()
+
+	_, err := store.Query(common.NewQuery().Match("fields.name", "cat"))
+	assertions.ErrorIs(err, replica.ErrUnsupportedQuery)
+
+	_, err = store.Query(common.NewQuery().Locale("*"))
+	assertions.ErrorIs(err, replica.ErrUnsupportedQuery)
+
+	_, err = store.Query(common.NewQuery().Equal("name", "Nyan Cat"))
+	assertions.ErrorIs(err, replica.ErrUnsupportedQuery)
+}
+
+func TestStore_Poll(t *testing.T) {
+	assertions := assert.New(t)
+
+	deltas := false
+	mutex := &sync.Mutex{}
+	store, closeServer := newStore(t, assertions, &deltas, mutex)
+	defer closeServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error)
+	go func() {
+		done <- store.Poll(ctx, 5*time.Millisecond, func(err error) {
+			t.Error(err)
+		})
+	}()
+
+	// read concurrently until the initial sync is applied
+	assertions.Eventually(func() bool {
+		_, err := store.Query(common.NewQuery().ContentType("cat"))
+		assertions.Nil(err)
+
+		_, ok := store.Entry("happycat")
+		return ok
+	}, time.Second, time.Millisecond)
+
+	mutex.Lock()
+	deltas = true
+	mutex.Unlock()
+
+	assertions.Eventually(func() bool {
+		_, ok := store.Entry("happycat")
+		return !ok
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	assertions.ErrorIs(<-done, context.Canceled)
+}
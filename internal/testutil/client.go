@@ -4,17 +4,23 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/labd/contentful-go"
 	client2 "github.com/labd/contentful-go/pkgs/client"
+	"github.com/labd/contentful-go/pkgs/httpcache"
 	"github.com/labd/contentful-go/pkgs/util"
+	"github.com/labd/contentful-go/service/cda"
 	"github.com/labd/contentful-go/service/cma"
+	"github.com/labd/contentful-go/service/graphql"
 	"github.com/stretchr/testify/assert"
 )
 
 var (
 	CMAToken       = "b4c0n73n7fu1"
+	CDAToken       = "d3l1v3ry70k3n"
+	PreviewToken   = "pr3v13w70k3n"
 	SpaceID        = "id1"
 	OrganizationId = "org1"
 )
@@ -26,9 +32,26 @@ type ValidateRequest func(r *http.Request)
 
 func checkHeaders(req *http.Request, assert *assert.Assertions) {
 	assert.Equal("Bearer "+CMAToken, req.Header.Get("Authorization"))
+
+	// the upload API works with raw bytes
+	if strings.Contains(req.URL.Path, "/uploads") {
+		assert.Equal("application/octet-stream", req.Header.Get("Content-Type"))
+		return
+	}
+
 	assert.Equal("application/vnd.contentful.management.v1+json", req.Header.Get("Content-Type"))
 }
 
+func checkCDAHeaders(req *http.Request, assert *assert.Assertions) {
+	assert.Equal("Bearer "+CDAToken, req.Header.Get("Authorization"))
+	assert.Equal("application/vnd.contentful.delivery.v1+json", req.Header.Get("Content-Type"))
+}
+
+func checkGraphQLHeaders(req *http.Request, assert *assert.Assertions) {
+	assert.Contains([]string{"Bearer " + CDAToken, "Bearer " + PreviewToken}, req.Header.Get("Authorization"))
+	assert.Equal("application/json", req.Header.Get("Content-Type"))
+}
+
 type ResponseData struct {
 	Path       string
 	StatusCode int
@@ -61,6 +84,7 @@ func MockCMAClient(
 
 	client, err := contentful.NewCMAV2(client2.ClientConfig{
 		URL:       util.ToPointer(ts.URL),
+		UploadURL: util.ToPointer(ts.URL),
 		Debug:     false,
 		UserAgent: util.ToPointer("testclient"),
 		Token:     CMAToken,
@@ -72,3 +96,92 @@ func MockCMAClient(
 
 	return client, ts
 }
+
+func MockCDAClient(
+	t *testing.T,
+	assertions *assert.Assertions,
+	fixture ResponseData,
+	callback HTTPHandler, validation ValidateRequest) (cda.SpaceIdClientBuilder, *httptest.Server) {
+	return MockCachedCDAClient(t, assertions, nil, fixture, callback, validation)
+}
+
+// MockCachedCDAClient creates a CDA client which caches its responses in the given response cache
+func MockCachedCDAClient(
+	t *testing.T,
+	assertions *assert.Assertions,
+	responseCache *httpcache.ResponseCache,
+	fixture ResponseData,
+	callback HTTPHandler, validation ValidateRequest) (cda.SpaceIdClientBuilder, *httptest.Server) {
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+
+		validation(r)
+
+		checkCDAHeaders(r, assertions)
+
+		if callback != nil {
+			callback(w, r)
+		} else {
+			w.WriteHeader(fixture.StatusCode)
+			if fixture.Path != "" {
+				_, _ = fmt.Fprintln(w, readTestData(fixture.Path))
+			}
+		}
+
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(handler))
+
+	client, err := contentful.NewCDAV2(client2.ClientConfig{
+		URL:           util.ToPointer(ts.URL),
+		Debug:         false,
+		UserAgent:     util.ToPointer("testclient"),
+		Token:         CDAToken,
+		ResponseCache: responseCache,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, ts
+}
+
+func MockGraphQLClient(
+	t *testing.T,
+	assertions *assert.Assertions,
+	fixture ResponseData,
+	callback HTTPHandler, validation ValidateRequest) (graphql.SpaceIdClientBuilder, *httptest.Server) {
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+
+		validation(r)
+
+		checkGraphQLHeaders(r, assertions)
+
+		if callback != nil {
+			callback(w, r)
+		} else {
+			w.WriteHeader(fixture.StatusCode)
+			if fixture.Path != "" {
+				_, _ = fmt.Fprintln(w, readTestData(fixture.Path))
+			}
+		}
+
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(handler))
+
+	client, err := contentful.NewGraphQL(client2.ClientConfig{
+		URL:       util.ToPointer(ts.URL),
+		Debug:     false,
+		UserAgent: util.ToPointer("testclient"),
+		Token:     CDAToken,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, ts
+}
@@ -0,0 +1,29 @@
+package testutil
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// PagedHandler serves the items of a collection fixture in pages of the given size,
+// using the skip query parameter of the request
+func PagedHandler(fileName string, limit int) HTTPHandler {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var collection map[string]any
+		_ = json.Unmarshal([]byte(readTestData(fileName)), &collection)
+
+		items, _ := collection["items"].([]any)
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+
+		end := min(skip+limit, len(items))
+		skip = min(skip, end)
+
+		collection["items"] = items[skip:end]
+		collection["skip"] = skip
+		collection["limit"] = limit
+		collection["total"] = len(items)
+
+		_ = json.NewEncoder(w).Encode(collection)
+	}
+}
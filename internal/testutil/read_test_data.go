@@ -18,6 +18,11 @@ func readTestData(fileName string) string {
 	return string(content)
 }
 
+// ReadTestData returns the contents of the given fixture
+func ReadTestData(fileName string) string {
+	return readTestData(fileName)
+}
+
 func ModelFromTestData(fileName string, value any) error {
 	content := readTestData(fileName)
 
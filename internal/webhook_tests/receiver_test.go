@@ -0,0 +1,224 @@
+package webhook_tests
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/webhook"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const secret = "t0ps3cr3t"
+
+// signedRequest creates a webhook request signed like Contentful does
+func signedRequest(topic string, body string, timestamp time.Time) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks?source=contentful", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/vnd.contentful.management.v1+json")
+	req.Header.Set(webhook.HeaderTopic, topic)
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp.UnixMilli(), 10))
+	req.Header.Set(webhook.HeaderSignedHeaders, "x-contentful-signed-headers,x-contentful-timestamp,x-contentful-topic")
+
+	canonical := strings.Join([]string{
+		http.MethodPost,
+		"/webhooks?source=contentful",
+		"x-contentful-signed-headers:" + req.Header.Get(webhook.HeaderSignedHeaders) +
+			";x-contentful-timestamp:" + req.Header.Get(webhook.HeaderTimestamp) +
+			";x-contentful-topic:" + topic,
+		body,
+	}, "\n")
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(canonical))
+	req.Header.Set(webhook.HeaderSignature, hex.EncodeToString(mac.Sum(nil)))
+
+	return req
+}
+
+func serve(receiver *webhook.Receiver, req *http.Request) int {
+	recorder := httptest.NewRecorder()
+	receiver.ServeHTTP(recorder, req)
+
+	return recorder.Code
+}
+
+func TestReceiver_Entry(t *testing.T) {
+	assertions := assert.New(t)
+
+	receiver := webhook.NewReceiver(webhook.ReceiverOptions{Secret: secret})
+
+	var published, all []string
+
+	receiver.Handle("ContentManagement.Entry.publish", func(ctx context.Context, event *webhook.Event) error {
+		published = append(published, event.Entry.Sys.ID)
+		assertions.Equal("Hello, World!", event.Entry.Fields["title"].(map[string]any)["en-US"])
+		return nil
+	})
+
+	receiver.Handle("ContentManagement.*.*", func(ctx context.Context, event *webhook.Event) error {
+		all = append(all, event.Topic.String())
+		return nil
+	})
+
+	receiver.Handle("ContentManagement.Asset.*", func(ctx context.Context, event *webhook.Event) error {
+		t.Error("asset handler called for an entry")
+		return nil
+	})
+
+	code := serve(receiver, signedRequest("ContentManagement.Entry.publish", testutil.ReadTestData("entry/get.json"), time.Now()))
+
+	assertions.Equal(http.StatusOK, code)
+	assertions.Equal([]string{"5KsDBWseXY6QegucYAoacS"}, published)
+	assertions.Equal([]string{"ContentManagement.Entry.publish"}, all)
+}
+
+func TestReceiver_Deleted(t *testing.T) {
+	assertions := assert.New(t)
+
+	receiver := webhook.NewReceiver(webhook.ReceiverOptions{Secret: secret})
+
+	var deleted *webhook.Event
+	receiver.Handle("ContentManagement.Entry.unpublish", func(ctx context.Context, event *webhook.Event) error {
+		deleted = event
+		return nil
+	})
+
+	code := serve(receiver, signedRequest("ContentManagement.Entry.unpublish", testutil.ReadTestData("webhook/entry_unpublish.json"), time.Now()))
+
+	assertions.Equal(http.StatusOK, code)
+	assertions.Nil(deleted.Entry)
+	assertions.Equal("DeletedEntry", deleted.Deleted.Type)
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", deleted.Deleted.ID)
+	assertions.Equal("2023-10-05T09:00:00.000Z", deleted.Deleted.DeletedAt)
+}
+
+func TestReceiver_Asset(t *testing.T) {
+	assertions := assert.New(t)
+
+	receiver := webhook.NewReceiver(webhook.ReceiverOptions{Secret: secret})
+
+	var title string
+	receiver.Handle("*", func(ctx context.Context, event *webhook.Event) error {
+		title = event.Asset.Fields.Title["en-US"]
+		return nil
+	})
+
+	code := serve(receiver, signedRequest("ContentManagement.Asset.archive", testutil.ReadTestData("asset/get.json"), time.Now()))
+
+	assertions.Equal(http.StatusOK, code)
+	assertions.NotEmpty(title)
+}
+
+func TestReceiver_Verification(t *testing.T) {
+	body := testutil.ReadTestData("entry/get.json")
+
+	tampered := signedRequest("ContentManagement.Entry.publish", body, time.Now())
+	tampered.Header.Set(webhook.HeaderTopic, "ContentManagement.Entry.delete")
+
+	unsigned := signedRequest("ContentManagement.Entry.publish", body, time.Now())
+	unsigned.Header.Del(webhook.HeaderSignature)
+
+	wrongPath := signedRequest("ContentManagement.Entry.publish", body, time.Now())
+	wrongPath.URL.RawQuery = "source=other"
+
+	cases := map[string]*http.Request{
+		"tampered header": tampered,
+		"unsigned":        unsigned,
+		"wrong path":      wrongPath,
+		"expired":         signedRequest("ContentManagement.Entry.publish", body, time.Now().Add(-time.Minute)),
+		"modified body":   signedRequest("ContentManagement.Entry.publish", body, time.Now()),
+	}
+
+	cases["modified body"].Body = http.NoBody
+
+	for name, req := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+
+			receiver := webhook.NewReceiver(webhook.ReceiverOptions{Secret: secret})
+			receiver.Handle("*", func(ctx context.Context, event *webhook.Event) error {
+				called = true
+				return nil
+			})
+
+			assert.Equal(t, http.StatusUnauthorized, serve(receiver, req))
+			assert.False(t, called)
+		})
+	}
+}
+
+func TestReceiver_Options(t *testing.T) {
+	assertions := assert.New(t)
+
+	unsigned := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader("{}"))
+		req.Header.Set(webhook.HeaderTopic, "ContentManagement.Entry.save")
+		return req
+	}
+
+	// without a secret requests are rejected, unless verification is skipped explicitly
+	assertions.Equal(http.StatusUnauthorized, serve(webhook.NewReceiver(webhook.ReceiverOptions{}), unsigned()))
+	assertions.Equal(http.StatusOK, serve(webhook.NewReceiver(webhook.ReceiverOptions{SkipVerification: true}), unsigned()))
+
+	// a longer ttl accepts older requests
+	receiver := webhook.NewReceiver(webhook.ReceiverOptions{Secret: secret, SignatureTTL: 2 * time.Minute})
+	assertions.Equal(http.StatusOK, serve(receiver, signedRequest("ContentManagement.Entry.publish", "{}", time.Now().Add(-time.Minute))))
+
+	get := httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+	assertions.Equal(http.StatusMethodNotAllowed, serve(receiver, get))
+
+	large := webhook.NewReceiver(webhook.ReceiverOptions{SkipVerification: true, MaxBodySize: 10})
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(`{"sys": {"type": "Entry"}}`))
+	req.Header.Set(webhook.HeaderTopic, "ContentManagement.Entry.save")
+	assertions.Equal(http.StatusRequestEntityTooLarge, serve(large, req))
+}
+
+func TestReceiver_InvalidTopic(t *testing.T) {
+	assertions := assert.New(t)
+
+	receiver := webhook.NewReceiver(webhook.ReceiverOptions{Secret: secret})
+
+	code := serve(receiver, signedRequest("Entry.publish", "{}", time.Now()))
+	assertions.Equal(http.StatusBadRequest, code)
+}
+
+func TestReceiver_HandlerError(t *testing.T) {
+	assertions := assert.New(t)
+
+	receiver := webhook.NewReceiver(webhook.ReceiverOptions{Secret: secret})
+	receiver.Handle("ContentManagement.Entry.*", func(ctx context.Context, event *webhook.Event) error {
+		return errors.New("database unavailable")
+	})
+
+	code := serve(receiver, signedRequest("ContentManagement.Entry.publish", testutil.ReadTestData("entry/get.json"), time.Now()))
+	assertions.Equal(http.StatusInternalServerError, code)
+}
+
+func TestTopic_Matches(t *testing.T) {
+	assertions := assert.New(t)
+
+	topic, err := webhook.ParseTopic("ContentManagement.Entry.publish")
+	assertions.Nil(err)
+	assertions.Equal("Entry", topic.Type)
+	assertions.Equal("publish", topic.Action)
+
+	assertions.True(topic.Matches("*"))
+	assertions.True(topic.Matches("ContentManagement.Entry.publish"))
+	assertions.True(topic.Matches("ContentManagement.*.publish"))
+	assertions.True(topic.Matches("*.Entry.*"))
+	assertions.False(topic.Matches("ContentManagement.Entry.unpublish"))
+	assertions.False(topic.Matches("ContentManagement.Entry"))
+
+	_, err = webhook.ParseTopic("ContentManagement..publish")
+	assertions.NotNil(err)
+}
@@ -0,0 +1,88 @@
+package webhook_tests
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labd/contentful-go/pkgs/webhook"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	assertions := assert.New(t)
+
+	body := `{"sys": {"type": "Entry"}}`
+	timestamp := time.Now()
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks?source=contentful", strings.NewReader(body))
+	req.Header.Set(webhook.HeaderTopic, "ContentManagement.Entry.publish")
+
+	err := webhook.Sign(req, secret, timestamp, "X-Contentful-Signed-Headers", webhook.HeaderTopic)
+	assertions.Nil(err)
+
+	// the signature matches the one Contentful creates for the same request
+	expected := signedRequest("ContentManagement.Entry.publish", body, timestamp)
+	assertions.Equal(expected.Header.Get(webhook.HeaderSignedHeaders), req.Header.Get(webhook.HeaderSignedHeaders))
+	assertions.Equal(expected.Header.Get(webhook.HeaderSignature), req.Header.Get(webhook.HeaderSignature))
+
+	// the body can still be read
+	read, err := io.ReadAll(req.Body)
+	assertions.Nil(err)
+	assertions.Equal(body, string(read))
+}
+
+func TestVerify(t *testing.T) {
+	assertions := assert.New(t)
+
+	body := `{"sys": {"type": "Entry"}}`
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	req.Header.Set(webhook.HeaderTopic, "ContentManagement.Entry.publish")
+
+	err := webhook.Sign(req, secret, time.Now(), webhook.HeaderTopic)
+	assertions.Nil(err)
+
+	assertions.Nil(webhook.Verify(req, secret, 0))
+	assertions.ErrorIs(webhook.Verify(req, "other", 0), webhook.ErrInvalidSignature)
+
+	read, err := io.ReadAll(req.Body)
+	assertions.Nil(err)
+	assertions.Equal(body, string(read))
+
+	req.Header.Set(webhook.HeaderTopic, "ContentManagement.Entry.delete")
+	assertions.ErrorIs(webhook.Verify(req, secret, 0), webhook.ErrInvalidSignature)
+
+	expired := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	err = webhook.Sign(expired, secret, time.Now().Add(-time.Hour))
+	assertions.Nil(err)
+	assertions.ErrorIs(webhook.Verify(expired, secret, time.Minute), webhook.ErrExpiredSignature)
+	assertions.Nil(webhook.Verify(expired, secret, 2*time.Hour))
+
+	future := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	err = webhook.Sign(future, secret, time.Now().Add(time.Hour))
+	assertions.Nil(err)
+	assertions.ErrorIs(webhook.Verify(future, secret, time.Minute), webhook.ErrExpiredSignature)
+	assertions.Nil(webhook.Verify(future, secret, 2*time.Hour))
+
+	unsigned := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	assertions.ErrorIs(webhook.Verify(unsigned, secret, 0), webhook.ErrMissingSignature)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	assertions := assert.New(t)
+
+	first, err := webhook.GenerateSecret()
+	assertions.Nil(err)
+	assertions.Nil(webhook.ValidateSecret(first))
+
+	second, err := webhook.GenerateSecret()
+	assertions.Nil(err)
+	assertions.NotEqual(first, second)
+
+	assertions.ErrorIs(webhook.ValidateSecret(strings.Repeat("-", webhook.SecretLength)), webhook.ErrInvalidSecret)
+}
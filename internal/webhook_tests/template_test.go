@@ -0,0 +1,119 @@
+package webhook_tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/labd/contentful-go/internal/testutil"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/pkgs/webhook"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func templateContext() webhook.TemplateContext {
+	return webhook.TemplateContext{
+		Topic:   "ContentManagement.Entry.publish",
+		UserID:  "7BslKh9TdKGOK41VmLDjFZ",
+		Payload: json.RawMessage(testutil.ReadTestData("entry/get.json")),
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	assertions := assert.New(t)
+
+	template := json.RawMessage(`{
+		"id": "{ /payload/sys/id }",
+		"version": "{ /payload/sys/version }",
+		"title": "{/payload/fields/title}",
+		"message": "{ /user/sys/id } triggered { /topic } for { /payload/sys/id } v{ /payload/sys/version }",
+		"static": ["{ /payload/sys/type }", 42, true, null]
+	}`)
+
+	body, err := webhook.RenderTemplate(template, templateContext())
+	assertions.Nil(err)
+	assertions.JSONEq(`{
+		"id": "5KsDBWseXY6QegucYAoacS",
+		"version": 1,
+		"title": {"en-US": "Hello, World!"},
+		"message": "7BslKh9TdKGOK41VmLDjFZ triggered ContentManagement.Entry.publish for 5KsDBWseXY6QegucYAoacS v1",
+		"static": ["Entry", 42, true, null]
+	}`, string(body))
+}
+
+func TestRenderTemplate_Errors(t *testing.T) {
+	cases := map[string]string{
+		"unknown property":   `{"id": "{ /payload/sys/unknown }"}`,
+		"index out of range": `{"id": "{ /payload/sys/contentType/sys/id/0 }"}`,
+		"within text":        `{"id": "entry { /payload/fields/missing }"}`,
+		"invalid template":   `{"id": `,
+	}
+
+	for name, template := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := webhook.RenderTemplate(json.RawMessage(template), templateContext())
+			assert.ErrorIs(t, err, webhook.ErrTemplate)
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	assertions := assert.New(t)
+
+	includeContentLength := true
+
+	request, err := webhook.Render(&model.WebhookTransformation{
+		Method:               http.MethodPut,
+		ContentType:          model.WebhookContentTypeJSON,
+		IncludeContentLength: &includeContentLength,
+		Body:                 json.RawMessage(`{"id": "{ /payload/sys/id }"}`),
+	}, templateContext())
+
+	assertions.Nil(err)
+	assertions.Equal(http.MethodPut, request.Method)
+	assertions.Equal("application/json", request.Header.Get("Content-Type"))
+	assertions.JSONEq(`{"id": "5KsDBWseXY6QegucYAoacS"}`, string(request.Body))
+	assertions.Equal("31", request.Header.Get("Content-Length"))
+}
+
+func TestRender_Defaults(t *testing.T) {
+	assertions := assert.New(t)
+
+	context := templateContext()
+
+	request, err := webhook.Render(nil, context)
+	assertions.Nil(err)
+	assertions.Equal(http.MethodPost, request.Method)
+	assertions.Equal(model.WebhookContentTypeManagement, request.Header.Get("Content-Type"))
+	assertions.Equal([]byte(context.Payload), request.Body)
+	assertions.Empty(request.Header.Get("Content-Length"))
+}
+
+func TestRender_FormURLEncoded(t *testing.T) {
+	assertions := assert.New(t)
+
+	request, err := webhook.Render(&model.WebhookTransformation{
+		ContentType: model.WebhookContentTypeFormURLEncoded,
+		Body:        json.RawMessage(`{"id": "{ /payload/sys/id }", "version": "{ /payload/sys/version }", "title": "{ /payload/fields/title }"}`),
+	}, templateContext())
+
+	assertions.Nil(err)
+
+	values, err := url.ParseQuery(string(request.Body))
+	assertions.Nil(err)
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", values.Get("id"))
+	assertions.Equal("1", values.Get("version"))
+	assertions.Equal(`{"en-US":"Hello, World!"}`, values.Get("title"))
+}
+
+func TestRender_InvalidTransformation(t *testing.T) {
+	assertions := assert.New(t)
+
+	_, err := webhook.Render(&model.WebhookTransformation{Method: "OPTIONS"}, templateContext())
+	assertions.ErrorIs(err, webhook.ErrTemplate)
+
+	_, err = webhook.Render(&model.WebhookTransformation{ContentType: "text/plain"}, templateContext())
+	assertions.ErrorIs(err, webhook.ErrTemplate)
+}
@@ -0,0 +1,207 @@
+// Package access reconciles the members, teams and space roles of an organization
+// with a desired state, to automate onboarding and offboarding.
+package access
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/labd/contentful-go/pkgs/model"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInvalidConfig is returned when the desired state is inconsistent
+var ErrInvalidConfig = errors.New("invalid access config")
+
+// Config is the desired access to an organization. Members and teams which are
+// not listed are removed from the organization, access to spaces is only managed
+// for the listed spaces.
+type Config struct {
+	Members []Member `json:"members" yaml:"members"`
+	Teams   []Team   `json:"teams" yaml:"teams"`
+	Spaces  []Space  `json:"spaces" yaml:"spaces"`
+}
+
+// Member is a member of the organization, Role defaults to model.OrganizationRoleMember.
+// The names are only used to invite the member.
+type Member struct {
+	Email     string `json:"email" yaml:"email"`
+	Role      string `json:"role,omitempty" yaml:"role,omitempty"`
+	FirstName string `json:"firstName,omitempty" yaml:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty" yaml:"lastName,omitempty"`
+}
+
+// Team is a team of the organization, with the emails of its members
+type Team struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Members     []string `json:"members,omitempty" yaml:"members,omitempty"`
+}
+
+// Space lists who has access to the space with the given id
+type Space struct {
+	ID      string            `json:"id" yaml:"id"`
+	Members []SpaceAssignment `json:"members,omitempty" yaml:"members,omitempty"`
+	Teams   []SpaceAssignment `json:"teams,omitempty" yaml:"teams,omitempty"`
+}
+
+// SpaceAssignment gives the member with Email, or the team with name Team, either
+// the admin role or the roles, which are referred to by name or id
+type SpaceAssignment struct {
+	Email string   `json:"email,omitempty" yaml:"email,omitempty"`
+	Team  string   `json:"team,omitempty" yaml:"team,omitempty"`
+	Admin bool     `json:"admin,omitempty" yaml:"admin,omitempty"`
+	Roles []string `json:"roles,omitempty" yaml:"roles,omitempty"`
+}
+
+// Parse parses a YAML or JSON document and validates it
+func Parse(data []byte) (*Config, error) {
+	var config Config
+
+	err := yaml.Unmarshal(data, &config)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+
+	err = config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// Load parses the YAML or JSON file
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data)
+}
+
+// Validate checks that emails and team names are unique, that teams and spaces only
+// refer to listed members and teams, and that the organization keeps an owner
+func (c *Config) Validate() error {
+	var errs []error
+
+	members := make(map[string]bool, len(c.Members))
+	owners := 0
+
+	for _, member := range c.Members {
+		email := normalizeEmail(member.Email)
+
+		switch {
+		case email == "":
+			errs = append(errs, fmt.Errorf("%w: member without email", ErrInvalidConfig))
+		case members[email]:
+			errs = append(errs, fmt.Errorf("%w: member %s is listed twice", ErrInvalidConfig, member.Email))
+		}
+
+		members[email] = true
+
+		switch member.Role {
+		case "", model.OrganizationRoleMember, model.OrganizationRoleDeveloper, model.OrganizationRoleAdmin:
+		case model.OrganizationRoleOwner:
+			owners++
+		default:
+			errs = append(errs, fmt.Errorf("%w: member %s has unknown role %s", ErrInvalidConfig, member.Email, member.Role))
+		}
+	}
+
+	if owners == 0 {
+		errs = append(errs, fmt.Errorf("%w: organization has no owner", ErrLastAdmin))
+	}
+
+	teams := make(map[string]bool, len(c.Teams))
+
+	for _, team := range c.Teams {
+		switch {
+		case team.Name == "":
+			errs = append(errs, fmt.Errorf("%w: team without name", ErrInvalidConfig))
+		case teams[team.Name]:
+			errs = append(errs, fmt.Errorf("%w: team %s is listed twice", ErrInvalidConfig, team.Name))
+		}
+
+		teams[team.Name] = true
+
+		for _, email := range team.Members {
+			if !members[normalizeEmail(email)] {
+				errs = append(errs, fmt.Errorf("%w: team %s has member %s which is not a member of the organization", ErrInvalidConfig, team.Name, email))
+			}
+		}
+	}
+
+	spaces := make(map[string]bool, len(c.Spaces))
+
+	for _, space := range c.Spaces {
+		switch {
+		case space.ID == "":
+			errs = append(errs, fmt.Errorf("%w: space without id", ErrInvalidConfig))
+		case spaces[space.ID]:
+			errs = append(errs, fmt.Errorf("%w: space %s is listed twice", ErrInvalidConfig, space.ID))
+		}
+
+		spaces[space.ID] = true
+
+		assigned := map[string]bool{}
+
+		for _, assignment := range space.Members {
+			email := normalizeEmail(assignment.Email)
+
+			switch {
+			case !members[email]:
+				errs = append(errs, fmt.Errorf("%w: space %s has member %s which is not a member of the organization", ErrInvalidConfig, space.ID, assignment.Email))
+			case assigned[email]:
+				errs = append(errs, fmt.Errorf("%w: space %s lists member %s twice", ErrInvalidConfig, space.ID, assignment.Email))
+			}
+
+			assigned[email] = true
+
+			errs = append(errs, assignment.validate(space.ID, assignment.Email))
+		}
+
+		for _, assignment := range space.Teams {
+			switch {
+			case !teams[assignment.Team]:
+				errs = append(errs, fmt.Errorf("%w: space %s has team %s which is not listed", ErrInvalidConfig, space.ID, assignment.Team))
+			case assigned["team:"+assignment.Team]:
+				errs = append(errs, fmt.Errorf("%w: space %s lists team %s twice", ErrInvalidConfig, space.ID, assignment.Team))
+			}
+
+			assigned["team:"+assignment.Team] = true
+
+			errs = append(errs, assignment.validate(space.ID, assignment.Team))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (a SpaceAssignment) validate(spaceId string, name string) error {
+	switch {
+	case a.Admin && len(a.Roles) > 0:
+		return fmt.Errorf("%w: %s in space %s is admin and has roles", ErrInvalidConfig, name, spaceId)
+	case !a.Admin && len(a.Roles) == 0:
+		return fmt.Errorf("%w: %s in space %s has no roles", ErrInvalidConfig, name, spaceId)
+	}
+
+	return nil
+}
+
+// role returns the organization role of the member
+func (m Member) role() string {
+	if m.Role == "" {
+		return model.OrganizationRoleMember
+	}
+
+	return m.Role
+}
+
+// normalizeEmail makes emails comparable, since Contentful does not distinguish case
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
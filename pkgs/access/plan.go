@@ -0,0 +1,107 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type Action string
+
+// noinspection GoUnusedConst
+const (
+	ActionInvite            Action = "invite"
+	ActionUpdateRole        Action = "update-role"
+	ActionRemoveMember      Action = "remove-member"
+	ActionCreateTeam        Action = "create-team"
+	ActionUpdateTeam        Action = "update-team"
+	ActionDeleteTeam        Action = "delete-team"
+	ActionAddTeamMember     Action = "add-team-member"
+	ActionRemoveTeamMember  Action = "remove-team-member"
+	ActionAssignSpace       Action = "assign-space"
+	ActionUnassignSpace     Action = "unassign-space"
+	ActionAssignTeamSpace   Action = "assign-team-space"
+	ActionUnassignTeamSpace Action = "unassign-team-space"
+)
+
+// Change is a single step of a plan. Email, Team and SpaceID identify what is
+// changed, depending on the action. Role is the organization role for invites and
+// role updates, Admin and Roles are the role names assigned in a space.
+type Change struct {
+	Action  Action
+	Email   string
+	Team    string
+	SpaceID string
+	Role    string
+	Admin   bool
+	Roles   []string
+
+	apply func(ctx context.Context) error
+}
+
+// String describes the change, like: assign-space grace@example.com in id1: Editor
+func (c *Change) String() string {
+	description := string(c.Action)
+
+	switch {
+	case c.Email != "" && c.Team != "" && c.Action == ActionRemoveTeamMember:
+		description += " " + c.Email + " from team " + c.Team
+	case c.Email != "" && c.Team != "":
+		description += " " + c.Email + " to team " + c.Team
+	case c.Email != "":
+		description += " " + c.Email
+	case c.Team != "":
+		description += " team " + c.Team
+	}
+
+	if c.SpaceID != "" {
+		description += " in " + c.SpaceID
+	}
+
+	switch {
+	case c.Role != "":
+		description += ": " + c.Role
+	case c.Admin:
+		description += ": admin"
+	case len(c.Roles) > 0:
+		description += ": " + strings.Join(c.Roles, ", ")
+	}
+
+	return description
+}
+
+// Plan lists the changes which bring the organization in the desired state, in
+// the order they are applied. Members and teams are added before they are given
+// access and access is granted before it is revoked, so spaces never lose their
+// last admin while the plan is applied.
+type Plan struct {
+	Changes []*Change
+}
+
+// Empty reports whether the organization is in the desired state
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String lists the changes, one per line
+func (p *Plan) String() string {
+	lines := make([]string, 0, len(p.Changes))
+	for _, change := range p.Changes {
+		lines = append(lines, change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Apply applies the changes in order and stops at the first error. Plans can only
+// be applied by the reconciler which created them.
+func (p *Plan) Apply(ctx context.Context) error {
+	for _, change := range p.Changes {
+		err := change.apply(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", change, err)
+		}
+	}
+
+	return nil
+}
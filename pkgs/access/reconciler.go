@@ -0,0 +1,657 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	cma2 "github.com/labd/contentful-go/internal/cma/common"
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+)
+
+// ErrLastAdmin is returned when the desired state would leave the organization
+// without owner or a space without admin. Current owners are only demoted or
+// removed when another current owner keeps its owner membership.
+var ErrLastAdmin = errors.New("last admin can not be removed")
+
+type Options struct {
+	// DryRun makes Reconcile return the plan without applying it
+	DryRun bool
+}
+
+// Reconciler brings the members, teams and space roles of an organization in the
+// desired state, using the membership and role services
+type Reconciler struct {
+	client       cma.SpaceIdClientBuilder
+	organization cma.OrganizationIdClient
+	dryRun       bool
+}
+
+func New(client cma.SpaceIdClientBuilder, organizationId string, options *Options) *Reconciler {
+	if options == nil {
+		options = &Options{}
+	}
+
+	return &Reconciler{
+		client:       client,
+		organization: client.WithOrganizationId(organizationId),
+		dryRun:       options.DryRun,
+	}
+}
+
+// Reconcile plans the changes and applies them, unless the reconciler is a dry run.
+// The plan is also returned when applying it fails, to report what was planned.
+func (r *Reconciler) Reconcile(ctx context.Context, config *Config) (*Plan, error) {
+	plan, err := r.Plan(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.dryRun {
+		return plan, nil
+	}
+
+	return plan, plan.Apply(ctx)
+}
+
+// state is the current state of the organization. It is updated while a plan is
+// applied, so changes can refer to members and teams created by earlier changes.
+type state struct {
+	emails        map[string]string
+	memberships   map[string]*model.OrganizationMembership
+	membershipIds map[string]string
+	teams         map[string]*model.Team
+	teamIds       map[string]string
+}
+
+// email returns the normalized email of the user, or the user id when the user is unknown
+func (s *state) email(user *model.Link) string {
+	if user == nil {
+		return ""
+	}
+
+	if email, ok := s.emails[user.Sys.ID]; ok {
+		return email
+	}
+
+	return user.Sys.ID
+}
+
+// Plan compares the desired state with the organization and returns the changes
+func (r *Reconciler) Plan(ctx context.Context, config *Config) (*Plan, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberGrants, memberRevokes, err := r.planMembers(config, current)
+	if err != nil {
+		return nil, err
+	}
+
+	teamGrants, teamRevokes := r.planTeams(config, current)
+
+	teamMemberGrants, teamMemberRevokes, err := r.planTeamMembers(ctx, config, current)
+	if err != nil {
+		return nil, err
+	}
+
+	var spaceGrants, spaceRevokes []*Change
+
+	for _, space := range config.Spaces {
+		grants, revokes, err := r.planSpace(ctx, config, space, current)
+		if err != nil {
+			return nil, err
+		}
+
+		spaceGrants = append(spaceGrants, grants...)
+		spaceRevokes = append(spaceRevokes, revokes...)
+	}
+
+	plan := &Plan{}
+
+	for _, changes := range [][]*Change{
+		memberGrants, teamGrants, teamMemberGrants, spaceGrants,
+		spaceRevokes, teamMemberRevokes, teamRevokes, memberRevokes,
+	} {
+		plan.Changes = append(plan.Changes, changes...)
+	}
+
+	return plan, nil
+}
+
+func (r *Reconciler) load(ctx context.Context) (*state, error) {
+	users, err := cma2.CollectAll(r.organization.Users().List(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := cma2.CollectAll(r.organization.OrganizationMemberships().List(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := cma2.CollectAll(r.organization.Teams().List(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	current := &state{
+		emails:        make(map[string]string, len(users)),
+		memberships:   make(map[string]*model.OrganizationMembership, len(memberships)),
+		membershipIds: make(map[string]string, len(memberships)),
+		teams:         make(map[string]*model.Team, len(teams)),
+		teamIds:       make(map[string]string, len(teams)),
+	}
+
+	for _, user := range users {
+		current.emails[user.Sys.ID] = normalizeEmail(user.Email)
+	}
+
+	for _, membership := range memberships {
+		email := current.email(membership.Sys.User)
+
+		current.memberships[email] = membership
+		current.membershipIds[email] = membership.Sys.ID
+	}
+
+	for _, team := range teams {
+		current.teams[team.Name] = team
+		current.teamIds[team.Name] = team.Sys.ID
+	}
+
+	return current, nil
+}
+
+func (r *Reconciler) planMembers(config *Config, current *state) (grants []*Change, revokes []*Change, err error) {
+	desired := make(map[string]bool, len(config.Members))
+
+	// current owners are only demoted or removed when an owner stays, invited owners
+	// do not count since the organization has no owner until they accept
+	keepsOwner := false
+
+	for _, member := range config.Members {
+		membership, ok := current.memberships[normalizeEmail(member.Email)]
+		if ok && member.role() == model.OrganizationRoleOwner && membership.Role == model.OrganizationRoleOwner {
+			keepsOwner = true
+		}
+	}
+
+	lastOwner := func(email string) error {
+		return fmt.Errorf("%w: %s is an owner and no other owner of the organization stays", ErrLastAdmin, email)
+	}
+
+	for _, member := range config.Members {
+		member := member
+		email := normalizeEmail(member.Email)
+		desired[email] = true
+
+		membership, ok := current.memberships[email]
+		if !ok {
+			invitation := &model.Invitation{
+				FirstName: member.FirstName,
+				LastName:  member.LastName,
+				Email:     member.Email,
+				Role:      member.role(),
+			}
+
+			grants = append(grants, &Change{
+				Action: ActionInvite,
+				Email:  email,
+				Role:   invitation.Role,
+				apply: func(ctx context.Context) error {
+					err := r.organization.Invitations().Create(ctx, invitation)
+					if err != nil {
+						return err
+					}
+
+					if invitation.Sys != nil && invitation.Sys.OrganizationMembership != nil {
+						current.membershipIds[email] = invitation.Sys.OrganizationMembership.Sys.ID
+					}
+
+					return nil
+				},
+			})
+
+			continue
+		}
+
+		if membership.Role == member.role() {
+			continue
+		}
+
+		change := &Change{
+			Action: ActionUpdateRole,
+			Email:  email,
+			Role:   member.role(),
+			apply: func(ctx context.Context) error {
+				membership.Role = member.role()
+				return r.organization.OrganizationMemberships().Update(ctx, membership)
+			},
+		}
+
+		// owners are demoted after new owners are promoted
+		if membership.Role == model.OrganizationRoleOwner {
+			if !keepsOwner {
+				return nil, nil, lastOwner(email)
+			}
+
+			revokes = append(revokes, change)
+		} else {
+			grants = append(grants, change)
+		}
+	}
+
+	for _, email := range sortedKeys(current.memberships) {
+		if desired[email] {
+			continue
+		}
+
+		membership := current.memberships[email]
+
+		if membership.Role == model.OrganizationRoleOwner && !keepsOwner {
+			return nil, nil, lastOwner(email)
+		}
+
+		revokes = append(revokes, &Change{
+			Action: ActionRemoveMember,
+			Email:  email,
+			apply: func(ctx context.Context) error {
+				return r.organization.OrganizationMemberships().Delete(ctx, membership)
+			},
+		})
+	}
+
+	return grants, revokes, nil
+}
+
+func (r *Reconciler) planTeams(config *Config, current *state) (grants []*Change, revokes []*Change) {
+	desired := make(map[string]bool, len(config.Teams))
+
+	for _, team := range config.Teams {
+		team := team
+		desired[team.Name] = true
+
+		existing, ok := current.teams[team.Name]
+		if !ok {
+			created := &model.Team{Name: team.Name, Description: team.Description}
+
+			grants = append(grants, &Change{
+				Action: ActionCreateTeam,
+				Team:   team.Name,
+				apply: func(ctx context.Context) error {
+					err := r.organization.Teams().Upsert(ctx, created)
+					if err != nil {
+						return err
+					}
+
+					current.teamIds[created.Name] = created.Sys.ID
+
+					return nil
+				},
+			})
+
+			continue
+		}
+
+		if existing.Description == team.Description {
+			continue
+		}
+
+		grants = append(grants, &Change{
+			Action: ActionUpdateTeam,
+			Team:   team.Name,
+			apply: func(ctx context.Context) error {
+				existing.Description = team.Description
+				return r.organization.Teams().Upsert(ctx, existing)
+			},
+		})
+	}
+
+	for _, name := range sortedKeys(current.teams) {
+		if desired[name] {
+			continue
+		}
+
+		team := current.teams[name]
+
+		revokes = append(revokes, &Change{
+			Action: ActionDeleteTeam,
+			Team:   name,
+			apply: func(ctx context.Context) error {
+				return r.organization.Teams().Delete(ctx, team)
+			},
+		})
+	}
+
+	return grants, revokes
+}
+
+func (r *Reconciler) planTeamMembers(ctx context.Context, config *Config, current *state) (grants []*Change, revokes []*Change, err error) {
+	memberships, err := cma2.CollectAll(r.organization.TeamMemberships().ListAll(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byTeam := map[string]map[string]*model.TeamMembership{}
+
+	for _, membership := range memberships {
+		teamId := membership.Sys.Team.Sys.ID
+		if byTeam[teamId] == nil {
+			byTeam[teamId] = map[string]*model.TeamMembership{}
+		}
+
+		byTeam[teamId][current.email(membership.Sys.User)] = membership
+	}
+
+	for _, team := range config.Teams {
+		team := team
+
+		var existing map[string]*model.TeamMembership
+		if current.teams[team.Name] != nil {
+			existing = byTeam[current.teams[team.Name].Sys.ID]
+		}
+
+		desired := make(map[string]bool, len(team.Members))
+
+		for _, member := range team.Members {
+			email := normalizeEmail(member)
+			desired[email] = true
+
+			if existing[email] != nil {
+				continue
+			}
+
+			grants = append(grants, &Change{
+				Action: ActionAddTeamMember,
+				Email:  email,
+				Team:   team.Name,
+				apply: func(ctx context.Context) error {
+					membership := &model.TeamMembership{OrganizationMembershipId: current.membershipIds[email]}
+					return r.organization.TeamMemberships().Create(ctx, current.teamIds[team.Name], membership)
+				},
+			})
+		}
+
+		for _, email := range sortedKeys(existing) {
+			if desired[email] {
+				continue
+			}
+
+			membership := existing[email]
+
+			revokes = append(revokes, &Change{
+				Action: ActionRemoveTeamMember,
+				Email:  email,
+				Team:   team.Name,
+				apply: func(ctx context.Context) error {
+					return r.organization.TeamMemberships().Delete(ctx, membership)
+				},
+			})
+		}
+	}
+
+	return grants, revokes, nil
+}
+
+func (r *Reconciler) planSpace(ctx context.Context, config *Config, space Space, current *state) (grants []*Change, revokes []*Change, err error) {
+	client := r.client.WithSpaceId(space.ID)
+
+	roles, err := cma2.CollectAll(client.Roles().List(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	memberships, err := cma2.CollectAll(client.SpaceMemberships().List(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teamMemberships, err := cma2.CollectAll(client.TeamSpaceMemberships().List(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hadAdmin := false
+	existing := make(map[string]*model.SpaceMembership, len(memberships))
+
+	for _, membership := range memberships {
+		existing[current.email(membership.User)] = membership
+		hadAdmin = hadAdmin || membership.Admin
+	}
+
+	existingTeams := make(map[string]*model.TeamSpaceMembership, len(teamMemberships))
+
+	for _, membership := range teamMemberships {
+		name := membership.TeamId()
+		for teamName, team := range current.teams {
+			if team.Sys.ID == membership.TeamId() {
+				name = teamName
+			}
+		}
+
+		existingTeams[name] = membership
+		hadAdmin = hadAdmin || membership.Admin
+	}
+
+	teamMembers := make(map[string]int, len(config.Teams))
+	for _, team := range config.Teams {
+		teamMembers[team.Name] = len(team.Members)
+	}
+
+	hasAdmin := false
+	desired := make(map[string]bool, len(space.Members))
+
+	for _, assignment := range space.Members {
+		assignment := assignment
+		email := normalizeEmail(assignment.Email)
+		desired[email] = true
+		hasAdmin = hasAdmin || assignment.Admin
+
+		roleIds, err := resolveRoles(space.ID, roles, assignment.Roles)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		membership, ok := existing[email]
+		if !ok {
+			grants = append(grants, &Change{
+				Action:  ActionAssignSpace,
+				Email:   email,
+				SpaceID: space.ID,
+				Admin:   assignment.Admin,
+				Roles:   assignment.Roles,
+				apply: func(ctx context.Context) error {
+					membership := model.NewSpaceMembership(assignment.Email, roleIds...)
+					if assignment.Admin {
+						membership.AssignAdmin()
+					}
+
+					return client.SpaceMemberships().Upsert(ctx, membership)
+				},
+			})
+
+			continue
+		}
+
+		if sameAssignment(membership.RoleAssignment, assignment.Admin, roleIds) {
+			continue
+		}
+
+		change := &Change{
+			Action:  ActionAssignSpace,
+			Email:   email,
+			SpaceID: space.ID,
+			Admin:   assignment.Admin,
+			Roles:   assignment.Roles,
+			apply: func(ctx context.Context) error {
+				if assignment.Admin {
+					membership.AssignAdmin()
+				} else {
+					membership.AssignRoles(roleIds...)
+				}
+
+				return client.SpaceMemberships().Upsert(ctx, membership)
+			},
+		}
+
+		// admins are demoted after new admins are assigned
+		if membership.Admin {
+			revokes = append(revokes, change)
+		} else {
+			grants = append(grants, change)
+		}
+	}
+
+	desiredTeams := make(map[string]bool, len(space.Teams))
+
+	for _, assignment := range space.Teams {
+		assignment := assignment
+		desiredTeams[assignment.Team] = true
+		hasAdmin = hasAdmin || (assignment.Admin && teamMembers[assignment.Team] > 0)
+
+		roleIds, err := resolveRoles(space.ID, roles, assignment.Roles)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		membership, ok := existingTeams[assignment.Team]
+		if !ok {
+			grants = append(grants, &Change{
+				Action:  ActionAssignTeamSpace,
+				Team:    assignment.Team,
+				SpaceID: space.ID,
+				Admin:   assignment.Admin,
+				Roles:   assignment.Roles,
+				apply: func(ctx context.Context) error {
+					membership := model.NewTeamSpaceMembership(current.teamIds[assignment.Team], roleIds...)
+					if assignment.Admin {
+						membership.AssignAdmin()
+					}
+
+					return client.TeamSpaceMemberships().Upsert(ctx, membership)
+				},
+			})
+
+			continue
+		}
+
+		if sameAssignment(membership.RoleAssignment, assignment.Admin, roleIds) {
+			continue
+		}
+
+		change := &Change{
+			Action:  ActionAssignTeamSpace,
+			Team:    assignment.Team,
+			SpaceID: space.ID,
+			Admin:   assignment.Admin,
+			Roles:   assignment.Roles,
+			apply: func(ctx context.Context) error {
+				if assignment.Admin {
+					membership.AssignAdmin()
+				} else {
+					membership.AssignRoles(roleIds...)
+				}
+
+				return client.TeamSpaceMemberships().Upsert(ctx, membership)
+			},
+		}
+
+		if membership.Admin {
+			revokes = append(revokes, change)
+		} else {
+			grants = append(grants, change)
+		}
+	}
+
+	if hadAdmin && !hasAdmin {
+		return nil, nil, fmt.Errorf("%w: space %s would have no admin", ErrLastAdmin, space.ID)
+	}
+
+	for _, email := range sortedKeys(existing) {
+		if desired[email] {
+			continue
+		}
+
+		membership := existing[email]
+
+		revokes = append(revokes, &Change{
+			Action:  ActionUnassignSpace,
+			Email:   email,
+			SpaceID: space.ID,
+			apply: func(ctx context.Context) error {
+				return client.SpaceMemberships().Delete(ctx, membership)
+			},
+		})
+	}
+
+	for _, name := range sortedKeys(existingTeams) {
+		if desiredTeams[name] {
+			continue
+		}
+
+		membership := existingTeams[name]
+
+		revokes = append(revokes, &Change{
+			Action:  ActionUnassignTeamSpace,
+			Team:    name,
+			SpaceID: space.ID,
+			apply: func(ctx context.Context) error {
+				return client.TeamSpaceMemberships().Delete(ctx, membership)
+			},
+		})
+	}
+
+	return grants, revokes, nil
+}
+
+// resolveRoles returns the ids of the roles, which are referred to by name or id
+func resolveRoles(spaceId string, roles []*model.Role, names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+
+	for _, name := range names {
+		index := slices.IndexFunc(roles, func(role *model.Role) bool {
+			return role.Name == name || role.Sys.ID == name
+		})
+
+		if index < 0 {
+			return nil, fmt.Errorf("%w: space %s has no role %s", ErrInvalidConfig, spaceId, name)
+		}
+
+		ids = append(ids, roles[index].Sys.ID)
+	}
+
+	return ids, nil
+}
+
+func sameAssignment(assignment model.RoleAssignment, admin bool, roleIds []string) bool {
+	if assignment.Admin != admin {
+		return false
+	}
+
+	current := assignment.RoleIds()
+	desired := slices.Clone(roleIds)
+
+	slices.Sort(current)
+	slices.Sort(desired)
+
+	return slices.Equal(current, desired)
+}
+
+func sortedKeys[V any](items map[string]V) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
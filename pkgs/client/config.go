@@ -3,14 +3,19 @@ package client
 import (
 	"log/slog"
 
+	"github.com/labd/contentful-go/pkgs/httpcache"
 	"github.com/labd/contentful-go/service/common"
 )
 
 type ClientConfig struct {
 	URL        *string
+	UploadURL  *string
 	HTTPClient common.HttpClient
 	Debug      bool
 	UserAgent  *string
 	Token      string
 	Logger     *slog.Logger
+	// ResponseCache caches responses of the Content Delivery API, it is not used
+	// by the other clients
+	ResponseCache *httpcache.ResponseCache
 }
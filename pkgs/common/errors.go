@@ -175,6 +175,41 @@ func (e RateLimitExceededError) Error() string {
 	return e.APIError.Err.Message
 }
 
+// PlanLimitExceededError is returned when the plan of the organization or space
+// does not allow more of a resource, like spaces, environments or locales
+type PlanLimitExceededError struct {
+	APIError
+}
+
+func (e PlanLimitExceededError) Error() string {
+	if e.APIError.Err.Details != nil && e.APIError.Err.Details.Reasons != "" {
+		return e.APIError.Err.Details.Reasons
+	}
+
+	return e.APIError.Err.Message
+}
+
+// Unwrap returns the error response, so the error is still found as an ErrorResponse
+// like other access denied responses
+func (e PlanLimitExceededError) Unwrap() error {
+	return *e.APIError.Err
+}
+
+// IsPlanLimitExceeded reports whether the error response denies access because a
+// limit of the plan is reached. This is a best-effort heuristic: the API returns
+// plan limits as AccessDenied or Forbidden without a dedicated id or field, so the
+// English text of the reasons is matched, and a change of its wording makes this
+// report false. Responses which are not recognised stay an ErrorResponse.
+func IsPlanLimitExceeded(e *ErrorResponse) bool {
+	if e.Sys == nil || (e.Sys.ID != "AccessDenied" && e.Sys.ID != "Forbidden") || e.Details == nil {
+		return false
+	}
+
+	reasons := strings.ToLower(e.Details.Reasons)
+
+	return strings.Contains(reasons, "reached the limit") || strings.Contains(reasons, "usage exceeded")
+}
+
 // BadRequestError error model for bad request responses
 type BadRequestError struct{}
 
@@ -201,3 +236,15 @@ func (e InvalidEntryError) Error() string {
 
 	return msg.String()
 }
+
+// UnexpectedResponseError is returned for error responses which are not in the
+// Contentful REST error format, like the responses of the GraphQL Content API
+type UnexpectedResponseError struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+func (e UnexpectedResponseError) Error() string {
+	return fmt.Sprintf("unexpected response with status code %d", e.StatusCode)
+}
@@ -0,0 +1,41 @@
+// Package httpcache caches GET responses of the Content Delivery API and
+// revalidates them with their ETag, to save requests against the rate limit.
+package httpcache
+
+import (
+	"net/http"
+	"time"
+)
+
+// Entry is a cached response
+type Entry struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	StoredAt   time.Time
+	// MaxAge is how long the response is fresh, after which it is revalidated
+	MaxAge time.Duration
+}
+
+// ETag returns the entity tag of the cached response
+func (e *Entry) ETag() string {
+	return e.Header.Get("ETag")
+}
+
+// Fresh reports whether the response can be used without revalidating it
+func (e *Entry) Fresh(now time.Time) bool {
+	return now.Sub(e.StoredAt) < e.MaxAge
+}
+
+// Cache stores responses by key. Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (*Entry, bool)
+	Set(key string, entry *Entry)
+	Delete(key string)
+}
+
+// EvictionNotifier is implemented by caches which remove responses by themselves,
+// like the LRU. The response cache uses it to forget evicted responses.
+type EvictionNotifier interface {
+	OnEvict(onEvict func(key string))
+}
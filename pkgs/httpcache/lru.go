@@ -0,0 +1,103 @@
+package httpcache
+
+import (
+	"container/list"
+	"sync"
+)
+
+var _ Cache = &LRU{}
+
+// LRU is an in-memory cache which removes the least recently used response
+// when it holds more than its capacity
+type LRU struct {
+	mutex    sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+	onEvict  func(key string)
+}
+
+type lruItem struct {
+	key   string
+	entry *Entry
+}
+
+func NewLRU(capacity int) *LRU {
+	return &LRU{
+		capacity: capacity,
+		items:    map[string]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+func (l *LRU) Get(key string) (*Entry, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	element, ok := l.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	l.order.MoveToFront(element)
+	return element.Value.(*lruItem).entry, true
+}
+
+// OnEvict registers a function which is called with the key of every response
+// removed because the cache is full
+func (l *LRU) OnEvict(onEvict func(key string)) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.onEvict = onEvict
+}
+
+func (l *LRU) Set(key string, entry *Entry) {
+	l.mutex.Lock()
+
+	if element, ok := l.items[key]; ok {
+		element.Value.(*lruItem).entry = entry
+		l.order.MoveToFront(element)
+		l.mutex.Unlock()
+		return
+	}
+
+	l.items[key] = l.order.PushFront(&lruItem{key: key, entry: entry})
+
+	var evicted []string
+
+	for l.capacity > 0 && l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.items, oldest.Value.(*lruItem).key)
+		evicted = append(evicted, oldest.Value.(*lruItem).key)
+	}
+
+	onEvict := l.onEvict
+	l.mutex.Unlock()
+
+	// called without holding the lock, so the callback may use the cache
+	if onEvict != nil {
+		for _, evictedKey := range evicted {
+			onEvict(evictedKey)
+		}
+	}
+}
+
+func (l *LRU) Delete(key string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if element, ok := l.items[key]; ok {
+		l.order.Remove(element)
+		delete(l.items, key)
+	}
+}
+
+// Len returns the number of cached responses
+func (l *LRU) Len() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.order.Len()
+}
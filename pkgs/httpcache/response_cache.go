@@ -0,0 +1,281 @@
+package httpcache
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labd/contentful-go/service/common"
+)
+
+// ResponseCache caches successful GET responses by URL, which includes the path
+// and query. Responses are fresh for their Cache-Control max-age, and revalidated
+// with If-None-Match afterwards when they have an ETag. Responses without either
+// are not cached, neither are responses with Cache-Control no-store.
+type ResponseCache struct {
+	cache Cache
+	mutex sync.Mutex
+	// keys holds the ids of the entities of every cached response
+	keys     map[string][]string
+	entities map[string]map[string]struct{}
+	now      func() time.Time
+}
+
+func New(cache Cache) *ResponseCache {
+	responseCache := &ResponseCache{
+		cache:    cache,
+		keys:     map[string][]string{},
+		entities: map[string]map[string]struct{}{},
+		now:      time.Now,
+	}
+
+	if notifier, ok := cache.(EvictionNotifier); ok {
+		notifier.OnEvict(responseCache.forget)
+	}
+
+	return responseCache
+}
+
+// Wrap returns an HTTP client which serves requests from the cache
+func (r *ResponseCache) Wrap(client common.HttpClient) common.HttpClient {
+	return &cachingClient{
+		client: client,
+		cache:  r,
+	}
+}
+
+// InvalidateEntity removes all cached responses containing the entry, asset or
+// other entity with the given id, for example after a webhook or sync event
+func (r *ResponseCache) InvalidateEntity(id string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for key := range r.entities[id] {
+		r.cache.Delete(key)
+		r.forgetLocked(key)
+	}
+}
+
+// InvalidateAll removes all responses cached through this response cache. Use it
+// when new entities are created, since these are not part of any cached list yet.
+func (r *ResponseCache) InvalidateAll() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for key := range r.keys {
+		r.cache.Delete(key)
+	}
+
+	r.keys = map[string][]string{}
+	r.entities = map[string]map[string]struct{}{}
+}
+
+func (r *ResponseCache) store(key string, entry *Entry) {
+	r.cache.Set(key, entry)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.forgetLocked(key)
+
+	ids := entityIds(entry.Body)
+	r.keys[key] = ids
+
+	for _, id := range ids {
+		if r.entities[id] == nil {
+			r.entities[id] = map[string]struct{}{}
+		}
+
+		r.entities[id][key] = struct{}{}
+	}
+}
+
+// Len returns the number of responses the response cache tracks for invalidation
+func (r *ResponseCache) Len() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return len(r.keys)
+}
+
+// forget removes a response which is no longer cached from the invalidation indexes
+func (r *ResponseCache) forget(key string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.forgetLocked(key)
+}
+
+func (r *ResponseCache) forgetLocked(key string) {
+	for _, id := range r.keys[key] {
+		delete(r.entities[id], key)
+
+		if len(r.entities[id]) == 0 {
+			delete(r.entities, id)
+		}
+	}
+
+	delete(r.keys, key)
+}
+
+type cachingClient struct {
+	client common.HttpClient
+	cache  *ResponseCache
+}
+
+func (c *cachingClient) Do(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || strings.Contains(req.Header.Get("Cache-Control"), "no-store") {
+		return c.client.Do(req)
+	}
+
+	key := req.URL.String()
+	now := c.cache.now()
+
+	cached, ok := c.cache.cache.Get(key)
+	if ok && cached.Fresh(now) {
+		return cached.response(req), nil
+	}
+
+	if ok && cached.ETag() != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.ETag())
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok && res.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		refreshed := *cached
+		refreshed.StoredAt = now
+
+		if maxAge, _, found := cachePolicy(res.Header); found {
+			refreshed.MaxAge = maxAge
+		}
+
+		c.cache.cache.Set(key, &refreshed)
+		return refreshed.response(req), nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		if res.StatusCode == http.StatusNotFound {
+			c.cache.cache.Delete(key)
+			c.cache.forget(key)
+		}
+
+		return res, nil
+	}
+
+	maxAge, cacheable, _ := cachePolicy(res.Header)
+	if !cacheable || (maxAge <= 0 && res.Header.Get("ETag") == "") {
+		return res, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.store(key, &Entry{
+		StatusCode: res.StatusCode,
+		Header:     res.Header.Clone(),
+		Body:       body,
+		StoredAt:   now,
+		MaxAge:     maxAge,
+	})
+
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	return res, nil
+}
+
+func (e *Entry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(e.StatusCode) + " " + http.StatusText(e.StatusCode),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// cachePolicy returns the max-age of a response and whether it can be stored at
+// all, and reports whether the response had a Cache-Control header
+func cachePolicy(header http.Header) (time.Duration, bool, bool) {
+	value := header.Get("Cache-Control")
+	if value == "" {
+		return 0, true, false
+	}
+
+	maxAge := time.Duration(0)
+
+	for _, directive := range strings.Split(value, ",") {
+		name, argument, _ := strings.Cut(strings.TrimSpace(strings.ToLower(directive)), "=")
+
+		switch name {
+		case "no-store":
+			return 0, false, true
+		case "no-cache":
+			return 0, true, true
+		case "max-age":
+			seconds, err := strconv.Atoi(strings.Trim(argument, `"`))
+			if err == nil && seconds > 0 {
+				maxAge = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	return maxAge, true, true
+}
+
+// entityIds returns the ids of the entity, collection items and includes of a response body
+func entityIds(body []byte) []string {
+	type withSys struct {
+		Sys struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"sys"`
+	}
+
+	var response struct {
+		withSys
+		Items    []withSys            `json:"items"`
+		Includes map[string][]withSys `json:"includes"`
+	}
+
+	if json.Unmarshal(body, &response) != nil {
+		return nil
+	}
+
+	var ids []string
+
+	if response.Sys.ID != "" && response.Sys.Type != "Array" {
+		ids = append(ids, response.Sys.ID)
+	}
+
+	items := response.Items
+	for _, included := range response.Includes {
+		items = append(items, included...)
+	}
+
+	for _, item := range items {
+		if item.Sys.ID != "" {
+			ids = append(ids, item.Sys.ID)
+		}
+	}
+
+	return ids
+}
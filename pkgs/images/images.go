@@ -0,0 +1,366 @@
+// Package images builds URLs for the Contentful Images API, which transforms
+// image assets through query parameters on the asset file URL.
+package images
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+var ErrInvalidTransformation = errors.New("invalid image transformation")
+
+var backgroundPattern = regexp.MustCompile(`^#?([0-9a-fA-F]{6})$`)
+
+// Builder holds the transformation of an image. Every option returns a modified
+// copy, so a builder can be used as the base of several variants.
+type Builder struct {
+	base          string
+	original      Format
+	originalWidth int
+
+	width       int
+	height      int
+	fit         Fit
+	focus       Focus
+	format      Format
+	progressive bool
+	png8        bool
+	quality     int
+	radius      int
+	maxRadius   bool
+	background  string
+}
+
+// New creates a builder for the given image URL. Protocol relative URLs, as
+// returned by the API, are changed to https.
+func New(fileURL string) Builder {
+	if strings.HasPrefix(fileURL, "//") {
+		fileURL = "https:" + fileURL
+	}
+
+	return Builder{base: fileURL}
+}
+
+// FromAsset creates a builder for the file of the asset in the given locale.
+// The original format and dimensions are used to validate the transformation
+// and to prevent upscaling in source sets.
+func FromAsset(asset *model.Asset, locale string) (Builder, error) {
+	if asset == nil || asset.Fields == nil {
+		return Builder{}, fmt.Errorf("asset has no fields")
+	}
+
+	file, ok := asset.Fields.File[locale]
+	if !ok || file == nil {
+		return Builder{}, fmt.Errorf("asset has no file for locale %s", locale)
+	}
+
+	if file.URL == "" {
+		return Builder{}, fmt.Errorf("file of asset for locale %s is not processed", locale)
+	}
+
+	if file.ContentType != "" && !strings.HasPrefix(file.ContentType, "image/") {
+		return Builder{}, fmt.Errorf("%w: %s is not an image", ErrInvalidTransformation, file.ContentType)
+	}
+
+	builder := New(file.URL)
+	builder.original = formatFromContentType(file.ContentType)
+
+	if file.Details != nil && file.Details.Image != nil {
+		builder.originalWidth = file.Details.Image.Width
+	}
+
+	return builder, nil
+}
+
+// Width sets the width in pixels
+func (b Builder) Width(width int) Builder {
+	b.width = width
+	return b
+}
+
+// Height sets the height in pixels
+func (b Builder) Height(height int) Builder {
+	b.height = height
+	return b
+}
+
+// Size sets both the width and the height in pixels
+func (b Builder) Size(width, height int) Builder {
+	b.width = width
+	b.height = height
+	return b
+}
+
+// Fit sets how the image is resized to the given dimensions
+func (b Builder) Fit(fit Fit) Builder {
+	b.fit = fit
+	return b
+}
+
+// Focus sets the area kept when the image is cropped
+func (b Builder) Focus(focus Focus) Builder {
+	b.focus = focus
+	return b
+}
+
+// Format sets the output format
+func (b Builder) Format(format Format) Builder {
+	b.format = format
+	return b
+}
+
+// Progressive renders the image as a progressive JPEG
+func (b Builder) Progressive() Builder {
+	b.format = FormatJPG
+	b.progressive = true
+	return b
+}
+
+// PNG8 renders the image as an 8-bit PNG
+func (b Builder) PNG8() Builder {
+	b.format = FormatPNG
+	b.png8 = true
+	return b
+}
+
+// Quality sets the quality between 1 and 100 of lossy formats
+func (b Builder) Quality(quality int) Builder {
+	b.quality = quality
+	return b
+}
+
+// Radius rounds the corners with the given radius in pixels
+func (b Builder) Radius(radius int) Builder {
+	b.radius = radius
+	b.maxRadius = false
+	return b
+}
+
+// MaxRadius crops the image to a circle or ellipse
+func (b Builder) MaxRadius() Builder {
+	b.radius = 0
+	b.maxRadius = true
+	return b
+}
+
+// Background sets the color used for padding and rounded corners, as an RGB hex
+// value like #ff0000
+func (b Builder) Background(color string) Builder {
+	b.background = color
+	return b
+}
+
+// outputFormat returns the format of the rendered image, or an empty format when unknown
+func (b Builder) outputFormat() Format {
+	if b.format != "" {
+		return b.format
+	}
+
+	return b.original
+}
+
+// Validate checks the options and their combinations
+func (b Builder) Validate() error {
+	if b.base == "" {
+		return fmt.Errorf("%w: missing image url", ErrInvalidTransformation)
+	}
+
+	if b.width < 0 || b.width > MaxDimension {
+		return fmt.Errorf("%w: width must be between 1 and %d", ErrInvalidTransformation, MaxDimension)
+	}
+
+	if b.height < 0 || b.height > MaxDimension {
+		return fmt.Errorf("%w: height must be between 1 and %d", ErrInvalidTransformation, MaxDimension)
+	}
+
+	if b.format != "" && !b.format.valid() {
+		return fmt.Errorf("%w: unknown format %s", ErrInvalidTransformation, b.format)
+	}
+
+	format := b.outputFormat()
+
+	if b.progressive && format != FormatJPG {
+		return fmt.Errorf("%w: progressive is only supported for jpg", ErrInvalidTransformation)
+	}
+
+	if b.png8 && format != FormatPNG {
+		return fmt.Errorf("%w: png8 is only supported for png", ErrInvalidTransformation)
+	}
+
+	if b.quality != 0 {
+		if b.quality < 1 || b.quality > 100 {
+			return fmt.Errorf("%w: quality must be between 1 and 100", ErrInvalidTransformation)
+		}
+
+		if format == FormatGIF || (format == FormatPNG && !b.png8) {
+			return fmt.Errorf("%w: quality is not supported for %s", ErrInvalidTransformation, format)
+		}
+	}
+
+	if b.fit != "" {
+		if !b.fit.valid() {
+			return fmt.Errorf("%w: unknown fit %s", ErrInvalidTransformation, b.fit)
+		}
+
+		if b.width == 0 && b.height == 0 {
+			return fmt.Errorf("%w: fit requires a width or height", ErrInvalidTransformation)
+		}
+	}
+
+	if b.focus != "" {
+		if !b.focus.valid() {
+			return fmt.Errorf("%w: unknown focus %s", ErrInvalidTransformation, b.focus)
+		}
+
+		if !b.fit.crops() {
+			return fmt.Errorf("%w: focus requires fit fill, crop or thumb", ErrInvalidTransformation)
+		}
+	}
+
+	if b.radius < 0 {
+		return fmt.Errorf("%w: radius must be positive", ErrInvalidTransformation)
+	}
+
+	if b.background != "" {
+		if !backgroundPattern.MatchString(b.background) {
+			return fmt.Errorf("%w: background must be a hex color like #ff0000", ErrInvalidTransformation)
+		}
+
+		if b.fit != FitPad && b.radius == 0 && !b.maxRadius {
+			return fmt.Errorf("%w: background requires fit pad or a radius", ErrInvalidTransformation)
+		}
+	}
+
+	return nil
+}
+
+// Query returns the query parameters of the transformation
+func (b Builder) Query() url.Values {
+	query := url.Values{}
+
+	if b.width > 0 {
+		query.Set("w", strconv.Itoa(b.width))
+	}
+
+	if b.height > 0 {
+		query.Set("h", strconv.Itoa(b.height))
+	}
+
+	if b.fit != "" {
+		query.Set("fit", string(b.fit))
+	}
+
+	if b.focus != "" {
+		query.Set("f", string(b.focus))
+	}
+
+	if b.format != "" {
+		query.Set("fm", string(b.format))
+	}
+
+	if b.progressive {
+		query.Set("fl", "progressive")
+	}
+
+	if b.png8 {
+		query.Set("fl", "png8")
+	}
+
+	if b.quality > 0 {
+		query.Set("q", strconv.Itoa(b.quality))
+	}
+
+	if b.maxRadius {
+		query.Set("r", "max")
+	} else if b.radius > 0 {
+		query.Set("r", strconv.Itoa(b.radius))
+	}
+
+	if match := backgroundPattern.FindStringSubmatch(b.background); match != nil {
+		query.Set("bg", "rgb:"+strings.ToLower(match[1]))
+	}
+
+	return query
+}
+
+// URL validates the transformation and returns the URL of the transformed image
+func (b Builder) URL() (string, error) {
+	if err := b.Validate(); err != nil {
+		return "", err
+	}
+
+	query := b.Query()
+	if len(query) == 0 {
+		return b.base, nil
+	}
+
+	separator := "?"
+	if strings.Contains(b.base, "?") {
+		separator = "&"
+	}
+
+	return b.base + separator + query.Encode(), nil
+}
+
+// SrcSet returns a srcset attribute value with a variant for each of the given
+// widths. A height set on the builder is scaled along to keep the aspect ratio.
+// When the original width is known, widths above it are left out to prevent
+// upscaling, and the original width is used if no width remains.
+func (b Builder) SrcSet(widths ...int) (string, error) {
+	if len(widths) == 0 {
+		return "", fmt.Errorf("%w: srcset requires at least one width", ErrInvalidTransformation)
+	}
+
+	for _, width := range widths {
+		if width < 1 {
+			return "", fmt.Errorf("%w: srcset width %d must be positive", ErrInvalidTransformation, width)
+		}
+	}
+
+	sorted := append([]int{}, widths...)
+	sort.Ints(sorted)
+
+	var candidates []int
+	for _, width := range sorted {
+		if b.originalWidth > 0 && width > b.originalWidth {
+			continue
+		}
+
+		if len(candidates) > 0 && candidates[len(candidates)-1] == width {
+			continue
+		}
+
+		candidates = append(candidates, width)
+	}
+
+	if len(candidates) == 0 {
+		candidates = []int{b.originalWidth}
+	}
+
+	parts := make([]string, 0, len(candidates))
+
+	for _, width := range candidates {
+		variant := b.Width(width)
+
+		if b.width > 0 && b.height > 0 {
+			variant.height = int(math.Round(float64(b.height) * float64(width) / float64(b.width)))
+		}
+
+		variantURL, err := variant.URL()
+		if err != nil {
+			return "", err
+		}
+
+		parts = append(parts, fmt.Sprintf("%s %dw", variantURL, width))
+	}
+
+	return strings.Join(parts, ", "), nil
+}
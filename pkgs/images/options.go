@@ -0,0 +1,102 @@
+package images
+
+// MaxDimension is the largest width or height the Images API can render
+const MaxDimension = 4000
+
+// Format is the output format of a transformed image
+type Format string
+
+// noinspection GoUnusedConst
+const (
+	FormatJPG  Format = "jpg"
+	FormatPNG  Format = "png"
+	FormatWebP Format = "webp"
+	FormatGIF  Format = "gif"
+	FormatAVIF Format = "avif"
+)
+
+// Fit is the resizing behavior used when both width and height are given
+type Fit string
+
+// noinspection GoUnusedConst
+const (
+	// FitPad resizes to the given dimensions and pads the remaining space with the background color
+	FitPad Fit = "pad"
+	// FitFill resizes to the given dimensions, cropping the image when needed
+	FitFill Fit = "fill"
+	// FitScale resizes to the given dimensions, changing the original aspect ratio
+	FitScale Fit = "scale"
+	// FitCrop crops a part of the original image to the given dimensions
+	FitCrop Fit = "crop"
+	// FitThumb creates a thumbnail, based on the focus area
+	FitThumb Fit = "thumb"
+)
+
+// Focus is the area of the image kept when cropping
+type Focus string
+
+// noinspection GoUnusedConst
+const (
+	FocusCenter      Focus = "center"
+	FocusTop         Focus = "top"
+	FocusRight       Focus = "right"
+	FocusLeft        Focus = "left"
+	FocusBottom      Focus = "bottom"
+	FocusTopRight    Focus = "top_right"
+	FocusTopLeft     Focus = "top_left"
+	FocusBottomRight Focus = "bottom_right"
+	FocusBottomLeft  Focus = "bottom_left"
+	FocusFace        Focus = "face"
+	FocusFaces       Focus = "faces"
+)
+
+func (f Format) valid() bool {
+	switch f {
+	case FormatJPG, FormatPNG, FormatWebP, FormatGIF, FormatAVIF:
+		return true
+	}
+
+	return false
+}
+
+func (f Fit) valid() bool {
+	switch f {
+	case FitPad, FitFill, FitScale, FitCrop, FitThumb:
+		return true
+	}
+
+	return false
+}
+
+// crops reports whether the fit removes parts of the image, which makes a focus area meaningful
+func (f Fit) crops() bool {
+	return f == FitFill || f == FitCrop || f == FitThumb
+}
+
+func (f Focus) valid() bool {
+	switch f {
+	case FocusCenter, FocusTop, FocusRight, FocusLeft, FocusBottom, FocusTopRight,
+		FocusTopLeft, FocusBottomRight, FocusBottomLeft, FocusFace, FocusFaces:
+		return true
+	}
+
+	return false
+}
+
+// formatFromContentType returns the format of the original image, or an empty format when unknown
+func formatFromContentType(contentType string) Format {
+	switch contentType {
+	case "image/jpeg", "image/jpg", "image/pjpeg":
+		return FormatJPG
+	case "image/png":
+		return FormatPNG
+	case "image/webp":
+		return FormatWebP
+	case "image/gif":
+		return FormatGIF
+	case "image/avif":
+		return FormatAVIF
+	}
+
+	return ""
+}
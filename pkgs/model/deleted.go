@@ -0,0 +1,9 @@
+package model
+
+// DeletedSys is the sys of a deleted entry or asset, as returned by the Sync API
+type DeletedSys struct {
+	BaseSys
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+	DeletedAt string `json:"deletedAt,omitempty"`
+}
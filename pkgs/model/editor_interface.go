@@ -0,0 +1,252 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// noinspection GoUnusedConst
+const (
+	WidgetNamespaceBuiltin        = "builtin"
+	WidgetNamespaceExtension      = "extension"
+	WidgetNamespaceApp            = "app"
+	WidgetNamespaceEditorBuiltin  = "editor-builtin"
+	WidgetNamespaceSidebarBuiltin = "sidebar-builtin"
+)
+
+type EditorInterfaceSys struct {
+	EnvironmentSys
+	ContentType *Link `json:"contentType,omitempty"`
+}
+
+// EditorInterface configures the entry editor of a content type: the control of
+// every field, the editors and sidebar widgets, and the layout of fields in tabs
+// and field groups
+type EditorInterface struct {
+	Sys           *EditorInterfaceSys `json:"sys,omitempty"`
+	Controls      []*EditorControl    `json:"controls,omitempty"`
+	Sidebar       []*EditorWidget     `json:"sidebar,omitempty"`
+	Editor        *EditorWidget       `json:"editor,omitempty"`
+	Editors       []*EditorWidget     `json:"editors,omitempty"`
+	EditorLayout  []*EditorLayoutItem `json:"editorLayout,omitempty"`
+	GroupControls []*GroupControl     `json:"groupControls,omitempty"`
+}
+
+// WidgetSettings are the instance parameters of a widget, which depend on the widget,
+// like helpText for builtin widgets or any parameter of an app or extension
+type WidgetSettings map[string]any
+
+// EditorControl selects the widget which edits a field
+type EditorControl struct {
+	FieldID         string         `json:"fieldId"`
+	WidgetNamespace string         `json:"widgetNamespace,omitempty"`
+	WidgetID        string         `json:"widgetId,omitempty"`
+	Settings        WidgetSettings `json:"settings,omitempty"`
+}
+
+// EditorWidget is a custom editor or a widget in the sidebar
+type EditorWidget struct {
+	WidgetNamespace string         `json:"widgetNamespace"`
+	WidgetID        string         `json:"widgetId"`
+	Settings        WidgetSettings `json:"settings,omitempty"`
+	Disabled        bool           `json:"disabled,omitempty"`
+}
+
+// EditorLayoutItem is either a field, identified by FieldID, or a group of items.
+// Groups at the top level are shown as tabs, nested groups as field sets.
+type EditorLayoutItem struct {
+	GroupID string              `json:"groupId,omitempty"`
+	Name    string              `json:"name,omitempty"`
+	FieldID string              `json:"fieldId,omitempty"`
+	Items   []*EditorLayoutItem `json:"items,omitempty"`
+}
+
+// GroupControl selects the widget which renders a group of the editor layout
+type GroupControl struct {
+	GroupID         string         `json:"groupId"`
+	WidgetNamespace string         `json:"widgetNamespace,omitempty"`
+	WidgetID        string         `json:"widgetId,omitempty"`
+	Settings        WidgetSettings `json:"settings,omitempty"`
+}
+
+// NewEditorLayoutField returns the layout item of a field
+func NewEditorLayoutField(fieldId string) *EditorLayoutItem {
+	return &EditorLayoutItem{FieldID: fieldId}
+}
+
+// NewEditorLayoutGroup returns a group with the items
+func NewEditorLayoutGroup(groupId string, name string, items ...*EditorLayoutItem) *EditorLayoutItem {
+	return &EditorLayoutItem{GroupID: groupId, Name: name, Items: items}
+}
+
+// IsGroup reports whether the item is a group
+func (i *EditorLayoutItem) IsGroup() bool {
+	return i.GroupID != ""
+}
+
+// MarshalJSON for custom json marshaling
+func (i *EditorLayoutItem) MarshalJSON() ([]byte, error) {
+	if !i.IsGroup() {
+		return json.Marshal(map[string]string{"fieldId": i.FieldID})
+	}
+
+	items := i.Items
+	if items == nil {
+		items = []*EditorLayoutItem{}
+	}
+
+	return json.Marshal(&struct {
+		GroupID string              `json:"groupId"`
+		Name    string              `json:"name"`
+		Items   []*EditorLayoutItem `json:"items"`
+	}{
+		GroupID: i.GroupID,
+		Name:    i.Name,
+		Items:   items,
+	})
+}
+
+// MarshalJSON for custom json marshaling, empty lists are kept since an empty sidebar
+// or list of editors differs from the default one used when the list is omitted
+func (e *EditorInterface) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Sys           *EditorInterfaceSys  `json:"sys,omitempty"`
+		Controls      *[]*EditorControl    `json:"controls,omitempty"`
+		Sidebar       *[]*EditorWidget     `json:"sidebar,omitempty"`
+		Editor        *EditorWidget        `json:"editor,omitempty"`
+		Editors       *[]*EditorWidget     `json:"editors,omitempty"`
+		EditorLayout  *[]*EditorLayoutItem `json:"editorLayout,omitempty"`
+		GroupControls *[]*GroupControl     `json:"groupControls,omitempty"`
+	}{
+		Sys:           e.Sys,
+		Controls:      keepEmpty(e.Controls),
+		Sidebar:       keepEmpty(e.Sidebar),
+		Editor:        e.Editor,
+		Editors:       keepEmpty(e.Editors),
+		EditorLayout:  keepEmpty(e.EditorLayout),
+		GroupControls: keepEmpty(e.GroupControls),
+	})
+}
+
+// keepEmpty returns a pointer to the slice, or nil when the slice is nil
+func keepEmpty[T any](s []T) *[]T {
+	if s == nil {
+		return nil
+	}
+
+	return &s
+}
+
+// ContentTypeId returns the id of the content type the editor interface belongs to
+func (e *EditorInterface) ContentTypeId() string {
+	if e.Sys == nil || e.Sys.ContentType == nil {
+		return ""
+	}
+
+	return e.Sys.ContentType.Sys.ID
+}
+
+// Control returns the control of the field, or nil when the field has no control
+func (e *EditorInterface) Control(fieldId string) *EditorControl {
+	for _, control := range e.Controls {
+		if control.FieldID == fieldId {
+			return control
+		}
+	}
+
+	return nil
+}
+
+// SetControl sets the widget of the field, replacing its current control
+func (e *EditorInterface) SetControl(fieldId string, widgetNamespace string, widgetId string, settings WidgetSettings) *EditorControl {
+	control := e.Control(fieldId)
+	if control == nil {
+		control = &EditorControl{FieldID: fieldId}
+		e.Controls = append(e.Controls, control)
+	}
+
+	control.WidgetNamespace = widgetNamespace
+	control.WidgetID = widgetId
+	control.Settings = settings
+
+	return control
+}
+
+// UseExtension makes the extension the widget of the field, with the settings as
+// values of its instance parameters
+func (e *EditorInterface) UseExtension(fieldId string, extension *Extension, settings WidgetSettings) (*EditorControl, error) {
+	if extension.IsNew() {
+		return nil, errors.New("extension must be created before it can be used")
+	}
+
+	if extension.Extension.Sidebar {
+		return nil, fmt.Errorf("extension %s is a sidebar extension", extension.Sys.ID)
+	}
+
+	err := extension.ValidateInstanceParameters(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.SetControl(fieldId, WidgetNamespaceExtension, extension.Sys.ID, settings), nil
+}
+
+// AddSidebarExtension adds the extension to the sidebar, or updates its settings
+// when it is in the sidebar already
+func (e *EditorInterface) AddSidebarExtension(extension *Extension, settings WidgetSettings) (*EditorWidget, error) {
+	if extension.IsNew() {
+		return nil, errors.New("extension must be created before it can be used")
+	}
+
+	err := extension.ValidateInstanceParameters(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, widget := range e.Sidebar {
+		if widget.WidgetNamespace == WidgetNamespaceExtension && widget.WidgetID == extension.Sys.ID {
+			widget.Settings = settings
+			widget.Disabled = false
+
+			return widget, nil
+		}
+	}
+
+	widget := &EditorWidget{
+		WidgetNamespace: WidgetNamespaceExtension,
+		WidgetID:        extension.Sys.ID,
+		Settings:        settings,
+	}
+
+	e.Sidebar = append(e.Sidebar, widget)
+
+	return widget, nil
+}
+
+// GroupControl returns the control of the group, or nil when the group has no control
+func (e *EditorInterface) GroupControl(groupId string) *GroupControl {
+	for _, control := range e.GroupControls {
+		if control.GroupID == groupId {
+			return control
+		}
+	}
+
+	return nil
+}
+
+// GetVersion returns entity version
+func (e *EditorInterface) GetVersion() int {
+	version := 1
+	if e.Sys != nil {
+		version = e.Sys.Version
+	}
+
+	return version
+}
+
+func (e *EditorInterface) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&e)
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+type ChangeKind string
+
+// noinspection GoUnusedConst
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// FieldChange is a change of the value of a field in a single locale. From is nil
+// when the value was added, To is nil when it was removed.
+type FieldChange struct {
+	Field  string     `json:"field"`
+	Locale string     `json:"locale"`
+	Kind   ChangeKind `json:"kind"`
+	From   any        `json:"from,omitempty"`
+	To     any        `json:"to,omitempty"`
+}
+
+// String describes the change, like: body[en-US] modified
+func (c *FieldChange) String() string {
+	return fmt.Sprintf("%s[%s] %s", c.Field, c.Locale, c.Kind)
+}
+
+// DiffEntries compares the fields of two entries locale by locale and returns the
+// changes from one to the other, sorted by field and locale. Either entry may be nil.
+func DiffEntries(from *Entry, to *Entry) []*FieldChange {
+	fromFields := entryFields(from)
+	toFields := entryFields(to)
+
+	var changes []*FieldChange
+
+	for _, field := range unionKeys(fromFields, toFields) {
+		fromValues := localizedValues(fromFields[field])
+		toValues := localizedValues(toFields[field])
+
+		for _, locale := range unionKeys(fromValues, toValues) {
+			fromValue, inFrom := fromValues[locale]
+			toValue, inTo := toValues[locale]
+
+			change := &FieldChange{Field: field, Locale: locale, From: fromValue, To: toValue}
+
+			switch {
+			case !inFrom:
+				change.Kind = ChangeAdded
+			case !inTo:
+				change.Kind = ChangeRemoved
+			case !equalValues(fromValue, toValue):
+				change.Kind = ChangeModified
+			default:
+				continue
+			}
+
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// ApplyChanges sets the To value of every change on the entry, removed values are
+// deleted and fields without values are removed
+func (entry *Entry) ApplyChanges(changes ...*FieldChange) {
+	for _, change := range changes {
+		values := localizedValues(entry.Fields[change.Field])
+
+		if change.Kind == ChangeRemoved {
+			delete(values, change.Locale)
+
+			if len(values) == 0 {
+				delete(entry.Fields, change.Field)
+				continue
+			}
+		} else {
+			if values == nil {
+				values = map[string]any{}
+			}
+
+			values[change.Locale] = change.To
+		}
+
+		if entry.Fields == nil {
+			entry.Fields = map[string]any{}
+		}
+
+		entry.Fields[change.Field] = values
+	}
+}
+
+func entryFields(entry *Entry) map[string]any {
+	if entry == nil {
+		return nil
+	}
+
+	return entry.Fields
+}
+
+// localizedValues returns the values of a field by locale. Fields of entries which
+// were not decoded from JSON may use other map types, those are converted.
+func localizedValues(value any) map[string]any {
+	switch typed := value.(type) {
+	case nil:
+		return nil
+	case map[string]any:
+		return typed
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var values map[string]any
+	if json.Unmarshal(data, &values) != nil {
+		return nil
+	}
+
+	return values
+}
+
+// equalValues compares values by their JSON representation when they differ in
+// type, so an int equals the float64 decoded from the API
+func equalValues(a any, b any) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+
+	aData, aErr := json.Marshal(a)
+	bData, bErr := json.Marshal(b)
+
+	return aErr == nil && bErr == nil && bytes.Equal(aData, bData)
+}
+
+// unionKeys returns the keys of both maps, sorted
+func unionKeys(a map[string]any, b map[string]any) []string {
+	keys := make([]string, 0, len(a)+len(b))
+
+	for key := range a {
+		keys = append(keys, key)
+	}
+
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,207 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+)
+
+// ExtensionSrcdocMaxSize is the maximum size in bytes of the inline HTML of an extension
+const ExtensionSrcdocMaxSize = 200 * 1024
+
+// noinspection GoUnusedConst
+const (
+	ParameterTypeSymbol  = "Symbol"
+	ParameterTypeEnum    = "Enum"
+	ParameterTypeNumber  = "Number"
+	ParameterTypeBoolean = "Boolean"
+)
+
+var (
+	// ErrSrcdocTooLarge is returned when the HTML of an extension exceeds ExtensionSrcdocMaxSize
+	ErrSrcdocTooLarge = errors.New("extension srcdoc is too large")
+
+	// ErrInvalidParameter is returned when parameters do not match their definitions
+	ErrInvalidParameter = errors.New("invalid extension parameter")
+)
+
+// Extension model, a UI extension. Parameters are the values of the installation
+// parameters, instance parameters are set per field in the editor interface.
+type Extension struct {
+	Sys        *EnvironmentSys  `json:"sys,omitempty"`
+	Extension  ExtensionDetails `json:"extension"`
+	Parameters map[string]any   `json:"parameters,omitempty"`
+}
+
+// ExtensionDetails either has the URL of the extension as Src or its HTML as Srcdoc
+type ExtensionDetails struct {
+	Name       string               `json:"name"`
+	Src        string               `json:"src,omitempty"`
+	Srcdoc     string               `json:"srcdoc,omitempty"`
+	FieldTypes []FieldType          `json:"fieldTypes,omitempty"`
+	Sidebar    bool                 `json:"sidebar,omitempty"`
+	Parameters *ExtensionParameters `json:"parameters,omitempty"`
+}
+
+// ExtensionParameters defines the parameters set when the extension is installed
+// and the parameters set for every field which uses the extension
+type ExtensionParameters struct {
+	Installation []*ParameterDefinition `json:"installation,omitempty"`
+	Instance     []*ParameterDefinition `json:"instance,omitempty"`
+}
+
+// ParameterDefinition model. Options are the values of an Enum parameter, either
+// strings or objects mapping a value to its label.
+type ParameterDefinition struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Type        string           `json:"type"`
+	Required    bool             `json:"required,omitempty"`
+	Default     any              `json:"default,omitempty"`
+	Options     []any            `json:"options,omitempty"`
+	Labels      *ParameterLabels `json:"labels,omitempty"`
+}
+
+// ParameterLabels are the labels of an empty Enum or of the values of a Boolean
+type ParameterLabels struct {
+	Empty string `json:"empty,omitempty"`
+	True  string `json:"true,omitempty"`
+	False string `json:"false,omitempty"`
+}
+
+// LoadSrcdoc sets the HTML file as inline source of the extension, which replaces its URL
+func (e *Extension) LoadSrcdoc(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if len(data) > ExtensionSrcdocMaxSize {
+		return fmt.Errorf("%w: %s has %d bytes, at most %d are allowed", ErrSrcdocTooLarge, path, len(data), ExtensionSrcdocMaxSize)
+	}
+
+	e.Extension.Src = ""
+	e.Extension.Srcdoc = string(data)
+
+	return nil
+}
+
+// ValidateInstallationParameters checks the installation parameters against their definitions
+func (e *Extension) ValidateInstallationParameters() error {
+	var definitions []*ParameterDefinition
+	if e.Extension.Parameters != nil {
+		definitions = e.Extension.Parameters.Installation
+	}
+
+	return ValidateParameters(definitions, e.Parameters)
+}
+
+// ValidateInstanceParameters checks the settings of a field against the instance parameter definitions
+func (e *Extension) ValidateInstanceParameters(settings WidgetSettings) error {
+	var definitions []*ParameterDefinition
+	if e.Extension.Parameters != nil {
+		definitions = e.Extension.Parameters.Instance
+	}
+
+	return ValidateParameters(definitions, settings)
+}
+
+// ValidateParameters checks that required parameters have a value, that values have
+// the type of their definition and that there are no values without definition
+func ValidateParameters(definitions []*ParameterDefinition, values map[string]any) error {
+	var errs []error
+
+	for _, definition := range definitions {
+		value, ok := values[definition.ID]
+		if !ok {
+			if definition.Required && definition.Default == nil {
+				errs = append(errs, fmt.Errorf("%w: %s is required", ErrInvalidParameter, definition.ID))
+			}
+
+			continue
+		}
+
+		if !definition.accepts(value) {
+			errs = append(errs, fmt.Errorf("%w: %v is not a valid value for %s", ErrInvalidParameter, value, definition.ID))
+		}
+	}
+
+	ids := make([]string, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		if !slices.ContainsFunc(definitions, func(definition *ParameterDefinition) bool { return definition.ID == id }) {
+			errs = append(errs, fmt.Errorf("%w: %s is not defined", ErrInvalidParameter, id))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *ParameterDefinition) accepts(value any) bool {
+	switch p.Type {
+	case ParameterTypeSymbol:
+		_, ok := value.(string)
+		return ok
+	case ParameterTypeNumber:
+		switch value.(type) {
+		case int, int64, float64, json.Number:
+			return true
+		}
+
+		return false
+	case ParameterTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case ParameterTypeEnum:
+		option, ok := value.(string)
+		return ok && slices.Contains(p.OptionValues(), option)
+	}
+
+	return true
+}
+
+// OptionValues returns the values of the options of an Enum parameter
+func (p *ParameterDefinition) OptionValues() []string {
+	values := make([]string, 0, len(p.Options))
+
+	for _, option := range p.Options {
+		switch typed := option.(type) {
+		case string:
+			values = append(values, typed)
+		case map[string]any:
+			for value := range typed {
+				values = append(values, value)
+			}
+		}
+	}
+
+	return values
+}
+
+// GetVersion returns entity version
+func (e *Extension) GetVersion() int {
+	version := 1
+	if e.Sys != nil {
+		version = e.Sys.Version
+	}
+
+	return version
+}
+
+func (e *Extension) IsNew() bool {
+	return e.Sys == nil || e.Sys.ID == ""
+}
+
+func (e *Extension) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&e)
+}
@@ -0,0 +1,223 @@
+package model
+
+import "sort"
+
+// noinspection GoUnusedConst
+const (
+	LinkTypeEntry = "Entry"
+	LinkTypeAsset = "Asset"
+)
+
+// Link model
+type Link struct {
+	Sys BaseSys `json:"sys"`
+}
+
+// NewLink creates a link to the entity with the given link type and id
+func NewLink(linkType, id string) Link {
+	return Link{
+		Sys: BaseSys{
+			ID:       id,
+			Type:     "Link",
+			LinkType: linkType,
+		},
+	}
+}
+
+// FieldLink is a link to an entry or asset found in a field of an entry. Locale is
+// empty when the entry was fetched for a single locale, like on the Delivery API.
+type FieldLink struct {
+	Field    string
+	Locale   string
+	LinkType string
+	ID       string
+}
+
+// Links returns all entry and asset links in the fields of the entry, including
+// links in arrays and rich text documents
+func (entry *Entry) Links() []FieldLink {
+	var links []FieldLink
+
+	for _, field := range sortedKeys(entry.Fields) {
+		value := entry.Fields[field]
+		localized, ok := value.(map[string]any)
+
+		if !ok || isLink(localized) || isRichText(localized) {
+			links = appendLinks(links, field, "", value)
+			continue
+		}
+
+		for _, locale := range sortedKeys(localized) {
+			links = appendLinks(links, field, locale, localized[locale])
+		}
+	}
+
+	return links
+}
+
+// LinksTo reports whether any field of the entry links to the given entity
+func (entry *Entry) LinksTo(linkType, id string) bool {
+	for _, link := range entry.Links() {
+		if link.LinkType == linkType && link.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func appendLinks(links []FieldLink, field, locale string, value any) []FieldLink {
+	switch typed := value.(type) {
+	case map[string]any:
+		if isLink(typed) {
+			sys := typed["sys"].(map[string]any)
+			linkType, _ := sys["linkType"].(string)
+			id, _ := sys["id"].(string)
+
+			if linkType == LinkTypeEntry || linkType == LinkTypeAsset {
+				links = append(links, FieldLink{
+					Field:    field,
+					Locale:   locale,
+					LinkType: linkType,
+					ID:       id,
+				})
+			}
+
+			return links
+		}
+
+		for _, key := range sortedKeys(typed) {
+			links = appendLinks(links, field, locale, typed[key])
+		}
+	case []any:
+		for _, nested := range typed {
+			links = appendLinks(links, field, locale, nested)
+		}
+	}
+
+	return links
+}
+
+func isLink(value map[string]any) bool {
+	sys, ok := value["sys"].(map[string]any)
+	if !ok {
+		return false
+	}
+
+	return sys["type"] == "Link"
+}
+
+func isRichText(value map[string]any) bool {
+	_, ok := value["nodeType"]
+	return ok
+}
+
+func sortedKeys(value map[string]any) []string {
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// RemoveLinks removes all links to the given entity from the fields of the entry,
+// including array items and rich text nodes targeting it. It reports whether
+// anything was removed.
+func (entry *Entry) RemoveLinks(linkType, id string) bool {
+	removed := false
+
+	for field, value := range entry.Fields {
+		localized, ok := value.(map[string]any)
+
+		if !ok || isLink(localized) || isRichText(localized) {
+			if isLinkTo(value, linkType, id) {
+				delete(entry.Fields, field)
+				removed = true
+				continue
+			}
+
+			var changed bool
+			entry.Fields[field], changed = removeLinks(value, linkType, id)
+			removed = removed || changed
+			continue
+		}
+
+		for locale, localizedValue := range localized {
+			if isLinkTo(localizedValue, linkType, id) {
+				delete(localized, locale)
+				removed = true
+				continue
+			}
+
+			var changed bool
+			localized[locale], changed = removeLinks(localizedValue, linkType, id)
+			removed = removed || changed
+		}
+
+		if len(localized) == 0 {
+			delete(entry.Fields, field)
+		}
+	}
+
+	return removed
+}
+
+func removeLinks(value any, linkType, id string) (any, bool) {
+	removed := false
+
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, nested := range typed {
+			if key == "sys" {
+				continue
+			}
+
+			var changed bool
+			typed[key], changed = removeLinks(nested, linkType, id)
+			removed = removed || changed
+		}
+	case []any:
+		kept := make([]any, 0, len(typed))
+
+		for _, nested := range typed {
+			if isLinkTo(nested, linkType, id) || isRichTextNodeTo(nested, linkType, id) {
+				removed = true
+				continue
+			}
+
+			nested, changed := removeLinks(nested, linkType, id)
+			removed = removed || changed
+			kept = append(kept, nested)
+		}
+
+		return kept, removed
+	}
+
+	return value, removed
+}
+
+func isLinkTo(value any, linkType, id string) bool {
+	link, ok := value.(map[string]any)
+	if !ok || !isLink(link) {
+		return false
+	}
+
+	sys := link["sys"].(map[string]any)
+	return sys["linkType"] == linkType && sys["id"] == id
+}
+
+func isRichTextNodeTo(value any, linkType, id string) bool {
+	node, ok := value.(map[string]any)
+	if !ok || !isRichText(node) {
+		return false
+	}
+
+	data, ok := node["data"].(map[string]any)
+	if !ok {
+		return false
+	}
+
+	return isLinkTo(data["target"], linkType, id)
+}
@@ -0,0 +1,131 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// RoleAssignment assigns either the admin role or the given roles to a member of a space
+type RoleAssignment struct {
+	Admin bool   `json:"admin"`
+	Roles []Link `json:"roles"`
+}
+
+// AssignAdmin makes the member an administrator of the space, which excludes other roles
+func (r *RoleAssignment) AssignAdmin() {
+	r.Admin = true
+	r.Roles = []Link{}
+}
+
+// AssignRoles gives the member the roles, which takes away the admin role
+func (r *RoleAssignment) AssignRoles(roleIds ...string) {
+	r.Admin = false
+	r.Roles = make([]Link, 0, len(roleIds))
+
+	for _, roleId := range roleIds {
+		r.Roles = append(r.Roles, NewLink("Role", roleId))
+	}
+}
+
+// RoleIds returns the ids of the assigned roles
+func (r *RoleAssignment) RoleIds() []string {
+	ids := make([]string, 0, len(r.Roles))
+	for _, role := range r.Roles {
+		ids = append(ids, role.Sys.ID)
+	}
+
+	return ids
+}
+
+// SpaceMembership model. Email is only used to invite a user when the membership is
+// created, existing memberships refer to the user with User.
+type SpaceMembership struct {
+	Sys *SpaceSys `json:"sys,omitempty"`
+	RoleAssignment
+	User  *Link  `json:"user,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// NewSpaceMembership returns a membership inviting the user with the email with the roles
+func NewSpaceMembership(email string, roleIds ...string) *SpaceMembership {
+	membership := &SpaceMembership{Email: email}
+	membership.AssignRoles(roleIds...)
+
+	return membership
+}
+
+// NewAdminSpaceMembership returns a membership inviting the user with the email as administrator
+func NewAdminSpaceMembership(email string) *SpaceMembership {
+	membership := &SpaceMembership{Email: email}
+	membership.AssignAdmin()
+
+	return membership
+}
+
+// GetVersion returns entity version
+func (m *SpaceMembership) GetVersion() int {
+	version := 1
+	if m.Sys != nil {
+		version = m.Sys.Version
+	}
+
+	return version
+}
+
+func (m *SpaceMembership) IsNew() bool {
+	return m.Sys == nil || m.Sys.ID == ""
+}
+
+func (m *SpaceMembership) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&m)
+}
+
+type TeamSpaceMembershipSys struct {
+	SpaceSys
+	Team *Link `json:"team,omitempty"`
+}
+
+// TeamSpaceMembership gives all members of a team access to a space
+type TeamSpaceMembership struct {
+	Sys *TeamSpaceMembershipSys `json:"sys,omitempty"`
+	RoleAssignment
+}
+
+// NewTeamSpaceMembership returns a membership giving the team the roles in the space
+func NewTeamSpaceMembership(teamId string, roleIds ...string) *TeamSpaceMembership {
+	link := NewLink("Team", teamId)
+
+	membership := &TeamSpaceMembership{Sys: &TeamSpaceMembershipSys{Team: &link}}
+	membership.AssignRoles(roleIds...)
+
+	return membership
+}
+
+// TeamId returns the id of the team
+func (m *TeamSpaceMembership) TeamId() string {
+	if m.Sys == nil || m.Sys.Team == nil {
+		return ""
+	}
+
+	return m.Sys.Team.Sys.ID
+}
+
+// GetVersion returns entity version
+func (m *TeamSpaceMembership) GetVersion() int {
+	version := 1
+	if m.Sys != nil {
+		version = m.Sys.Version
+	}
+
+	return version
+}
+
+func (m *TeamSpaceMembership) IsNew() bool {
+	return m.Sys == nil || m.Sys.ID == ""
+}
+
+func (m *TeamSpaceMembership) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&m)
+}
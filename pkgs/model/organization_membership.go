@@ -0,0 +1,137 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// noinspection GoUnusedConst
+const (
+	OrganizationRoleOwner     = "owner"
+	OrganizationRoleAdmin     = "admin"
+	OrganizationRoleDeveloper = "developer"
+	OrganizationRoleMember    = "member"
+)
+
+type OrganizationSys struct {
+	CreatedSys
+	Organization *Link `json:"organization,omitempty"`
+}
+
+type OrganizationMembershipSys struct {
+	OrganizationSys
+	User *Link `json:"user,omitempty"`
+}
+
+// OrganizationMembership model, Status is false while the invitation is pending
+type OrganizationMembership struct {
+	Sys    *OrganizationMembershipSys `json:"sys,omitempty"`
+	Role   string                     `json:"role"`
+	Status bool                       `json:"status,omitempty"`
+}
+
+// UserId returns the id of the member
+func (m *OrganizationMembership) UserId() string {
+	if m.Sys == nil || m.Sys.User == nil {
+		return ""
+	}
+
+	return m.Sys.User.Sys.ID
+}
+
+// GetVersion returns entity version
+func (m *OrganizationMembership) GetVersion() int {
+	version := 1
+	if m.Sys != nil {
+		version = m.Sys.Version
+	}
+
+	return version
+}
+
+func (m *OrganizationMembership) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&m)
+}
+
+type InvitationSys struct {
+	OrganizationSys
+	Status                 string `json:"status,omitempty"`
+	InvitationURL          string `json:"invitationUrl,omitempty"`
+	User                   *Link  `json:"user,omitempty"`
+	OrganizationMembership *Link  `json:"organizationMembership,omitempty"`
+}
+
+// Invitation invites a user to the organization with an organization role
+type Invitation struct {
+	Sys       *InvitationSys `json:"sys,omitempty"`
+	FirstName string         `json:"firstName,omitempty"`
+	LastName  string         `json:"lastName,omitempty"`
+	Email     string         `json:"email"`
+	Role      string         `json:"role"`
+}
+
+func (i *Invitation) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&i)
+}
+
+type TeamSys struct {
+	OrganizationSys
+	MemberCount int `json:"memberCount,omitempty"`
+}
+
+// Team model
+type Team struct {
+	Sys         *TeamSys `json:"sys,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+}
+
+// GetVersion returns entity version
+func (t *Team) GetVersion() int {
+	version := 1
+	if t.Sys != nil {
+		version = t.Sys.Version
+	}
+
+	return version
+}
+
+func (t *Team) IsNew() bool {
+	return t.Sys == nil || t.Sys.ID == ""
+}
+
+func (t *Team) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&t)
+}
+
+type TeamMembershipSys struct {
+	OrganizationSys
+	Team                   *Link `json:"team,omitempty"`
+	User                   *Link `json:"user,omitempty"`
+	OrganizationMembership *Link `json:"organizationMembership,omitempty"`
+}
+
+// TeamMembership adds a member of the organization to a team. OrganizationMembershipId
+// is only used when the membership is created.
+type TeamMembership struct {
+	Sys                      *TeamMembershipSys `json:"sys,omitempty"`
+	Admin                    bool               `json:"admin,omitempty"`
+	OrganizationMembershipId string             `json:"organizationMembershipId,omitempty"`
+}
+
+// UserId returns the id of the member
+func (m *TeamMembership) UserId() string {
+	if m.Sys == nil || m.Sys.User == nil {
+		return ""
+	}
+
+	return m.Sys.User.Sys.ID
+}
+
+func (m *TeamMembership) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&m)
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+)
+
+// noinspection GoUnusedConst
+const (
+	AccessTokenScopeManage = "content_management_manage"
+	AccessTokenScopeRead   = "content_management_read"
+)
+
+// PersonalAccessTokenPrefix is the prefix of every personal access token value
+const PersonalAccessTokenPrefix = "CFPAT-"
+
+type PersonalAccessTokenSys struct {
+	CreatedSys
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt    *time.Time `json:"lastUsedAt,omitempty"`
+	RedactedValue string     `json:"redactedValue,omitempty"`
+}
+
+// PersonalAccessToken model. Token is only set in the response of creating the
+// token, ExpiresIn is only used to create it.
+type PersonalAccessToken struct {
+	Sys       *PersonalAccessTokenSys `json:"sys,omitempty"`
+	Name      string                  `json:"name"`
+	Scopes    []string                `json:"scopes"`
+	ExpiresIn *int                    `json:"expiresIn,omitempty"`
+	RevokedAt *time.Time              `json:"revokedAt,omitempty"`
+	Token     string                  `json:"token,omitempty"`
+}
+
+// NewPersonalAccessToken returns a token with the scopes which expires after the
+// duration, a duration of 0 creates a token which does not expire
+func NewPersonalAccessToken(name string, expiresIn time.Duration, scopes ...string) *PersonalAccessToken {
+	token := &PersonalAccessToken{Name: name, Scopes: scopes}
+
+	if expiresIn > 0 {
+		seconds := int(expiresIn.Seconds())
+		token.ExpiresIn = &seconds
+	}
+
+	return token
+}
+
+// ExpiresAt returns when the token expires, or nil when it does not expire
+func (t *PersonalAccessToken) ExpiresAt() *time.Time {
+	if t.Sys == nil {
+		return nil
+	}
+
+	return t.Sys.ExpiresAt
+}
+
+// IsRevoked reports whether the token is revoked
+func (t *PersonalAccessToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired reports whether the token is expired at the given time
+func (t *PersonalAccessToken) IsExpired(now time.Time) bool {
+	expiresAt := t.ExpiresAt()
+	return expiresAt != nil && !now.Before(*expiresAt)
+}
+
+// ExpiresWithin reports whether the token expires within the duration from now,
+// tokens which are already expired included
+func (t *PersonalAccessToken) ExpiresWithin(now time.Time, within time.Duration) bool {
+	return t.IsExpired(now.Add(within))
+}
+
+// Matches reports whether the token value, like CFPAT-..., belongs to this token.
+// Only the last characters of a token are known after it is created, so a token
+// value is matched with the known prefix and the redacted value. This is best-effort,
+// different tokens ending on the same characters all match the value.
+func (t *PersonalAccessToken) Matches(value string) bool {
+	if t.Token != "" {
+		return t.Token == value
+	}
+
+	if t.Sys == nil || !strings.HasPrefix(value, PersonalAccessTokenPrefix) {
+		return false
+	}
+
+	redacted := strings.TrimLeft(t.Sys.RedactedValue, "*.…")
+
+	return redacted != "" && len(value) >= len(PersonalAccessTokenPrefix)+len(redacted) &&
+		strings.HasSuffix(value, redacted)
+}
+
+func (t *PersonalAccessToken) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&t)
+}
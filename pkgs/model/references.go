@@ -0,0 +1,112 @@
+package model
+
+// EntryReferences is the response of the entry references endpoint
+type EntryReferences struct {
+	Items    []*Entry `json:"items"`
+	Includes struct {
+		Entry []*Entry `json:"Entry,omitempty"`
+		Asset []*Asset `json:"Asset,omitempty"`
+	} `json:"includes"`
+}
+
+// ReferenceGraph holds an entry and everything it references up to the requested depth
+type ReferenceGraph struct {
+	Root    *Entry
+	Entries map[string]*Entry
+	Assets  map[string]*Asset
+}
+
+// NewReferenceGraph creates a graph from the references response for the given root entry
+func NewReferenceGraph(rootId string, references *EntryReferences) *ReferenceGraph {
+	graph := &ReferenceGraph{
+		Entries: map[string]*Entry{},
+		Assets:  map[string]*Asset{},
+	}
+
+	entries := append(append([]*Entry{}, references.Items...), references.Includes.Entry...)
+
+	for _, entry := range entries {
+		if entry.Sys == nil {
+			continue
+		}
+
+		graph.Entries[entry.Sys.ID] = entry
+
+		if entry.Sys.ID == rootId {
+			graph.Root = entry
+		}
+	}
+
+	for _, asset := range references.Includes.Asset {
+		if asset.Sys == nil {
+			continue
+		}
+
+		graph.Assets[asset.Sys.ID] = asset
+	}
+
+	return graph
+}
+
+// Children returns the links of the given entry
+func (g *ReferenceGraph) Children(entryId string) []FieldLink {
+	entry, ok := g.Entries[entryId]
+	if !ok {
+		return nil
+	}
+
+	return entry.Links()
+}
+
+// Walk visits every link reachable from the root entry once, breadth first.
+// Walking stops when the visitor returns false.
+func (g *ReferenceGraph) Walk(visitor func(parent *Entry, link FieldLink) bool) {
+	if g.Root == nil {
+		return
+	}
+
+	visited := map[string]bool{LinkTypeEntry + ":" + g.Root.Sys.ID: true}
+	queue := []*Entry{g.Root}
+
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+
+		for _, link := range parent.Links() {
+			key := link.LinkType + ":" + link.ID
+			if visited[key] {
+				continue
+			}
+
+			visited[key] = true
+
+			if !visitor(parent, link) {
+				return
+			}
+
+			if child, ok := g.Entries[link.ID]; ok && link.LinkType == LinkTypeEntry {
+				queue = append(queue, child)
+			}
+		}
+	}
+}
+
+// Unresolved returns the reachable links which are not part of the graph, either
+// because they are beyond the requested depth or because the target does not exist
+func (g *ReferenceGraph) Unresolved() []FieldLink {
+	var links []FieldLink
+
+	g.Walk(func(parent *Entry, link FieldLink) bool {
+		if link.LinkType == LinkTypeEntry && g.Entries[link.ID] == nil {
+			links = append(links, link)
+		}
+
+		if link.LinkType == LinkTypeAsset && g.Assets[link.ID] == nil {
+			links = append(links, link)
+		}
+
+		return true
+	})
+
+	return links
+}
@@ -0,0 +1,149 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// noinspection GoUnusedConst
+const (
+	PolicyEffectAllow = "allow"
+	PolicyEffectDeny  = "deny"
+)
+
+// noinspection GoUnusedConst
+const (
+	RoleActionAll       = "all"
+	RoleActionRead      = "read"
+	RoleActionCreate    = "create"
+	RoleActionUpdate    = "update"
+	RoleActionDelete    = "delete"
+	RoleActionPublish   = "publish"
+	RoleActionUnpublish = "unpublish"
+	RoleActionArchive   = "archive"
+	RoleActionUnarchive = "unarchive"
+	RoleActionManage    = "manage"
+)
+
+// noinspection GoUnusedConst
+const (
+	PermissionContentModel       = "ContentModel"
+	PermissionSettings           = "Settings"
+	PermissionContentDelivery    = "ContentDelivery"
+	PermissionEnvironments       = "Environments"
+	PermissionEnvironmentAliases = "EnvironmentAliases"
+	PermissionTags               = "Tags"
+)
+
+// Role model
+type Role struct {
+	Sys         *SpaceSys              `json:"sys,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Policies    []*RolePolicy          `json:"policies"`
+	Permissions map[string]RoleActions `json:"permissions"`
+}
+
+// RolePolicy allows or denies the actions on the entries and assets matching the constraint
+type RolePolicy struct {
+	Effect     string      `json:"effect"`
+	Actions    RoleActions `json:"actions"`
+	Constraint Constraint  `json:"constraint,omitempty"`
+}
+
+// RoleActions are the actions of a policy or permission. The API returns "all"
+// instead of a list for all actions, which is kept as the single action RoleActionAll.
+type RoleActions []string
+
+// MarshalJSON for custom json marshaling
+func (a RoleActions) MarshalJSON() ([]byte, error) {
+	if a.IsAll() {
+		return json.Marshal(RoleActionAll)
+	}
+
+	if a == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]string(a))
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (a *RoleActions) UnmarshalJSON(data []byte) error {
+	var all string
+	if err := json.Unmarshal(data, &all); err == nil {
+		*a = RoleActions{all}
+		return nil
+	}
+
+	var actions []string
+	if err := json.Unmarshal(data, &actions); err != nil {
+		return err
+	}
+
+	*a = actions
+	return nil
+}
+
+// IsAll reports whether all actions are allowed or denied
+func (a RoleActions) IsAll() bool {
+	return len(a) == 1 && a[0] == RoleActionAll
+}
+
+// Contains reports whether the action is one of the actions
+func (a RoleActions) Contains(action string) bool {
+	for _, item := range a {
+		if item == action || item == RoleActionAll {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (p *RolePolicy) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		Effect     string          `json:"effect"`
+		Actions    RoleActions     `json:"actions"`
+		Constraint json.RawMessage `json:"constraint"`
+	}
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	p.Effect = payload.Effect
+	p.Actions = payload.Actions
+	p.Constraint = nil
+
+	if len(payload.Constraint) > 0 && string(payload.Constraint) != "null" {
+		constraint, err := ParseConstraint(payload.Constraint)
+		if err != nil {
+			return err
+		}
+
+		p.Constraint = constraint
+	}
+
+	return nil
+}
+
+// GetVersion returns entity version
+func (r *Role) GetVersion() int {
+	version := 1
+	if r.Sys != nil {
+		version = r.Sys.Version
+	}
+
+	return version
+}
+
+func (r *Role) IsNew() bool {
+	return r.Sys == nil || r.Sys.ID == ""
+}
+
+func (r *Role) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&r)
+}
@@ -0,0 +1,119 @@
+package model
+
+// PolicyBuilder builds a policy for common patterns, like allowing to edit only
+// entries of a content type in a locale:
+//
+//	AllowPolicy(RoleActionRead, RoleActionUpdate).Entries().ContentType("blogPost").Locales("de-DE").Build()
+type PolicyBuilder struct {
+	effect      string
+	actions     RoleActions
+	constraints []Constraint
+	fields      []string
+	locales     []string
+}
+
+// AllowPolicy starts a policy allowing the actions, all actions when none are given
+func AllowPolicy(actions ...string) *PolicyBuilder {
+	return newPolicyBuilder(PolicyEffectAllow, actions)
+}
+
+// DenyPolicy starts a policy denying the actions, all actions when none are given
+func DenyPolicy(actions ...string) *PolicyBuilder {
+	return newPolicyBuilder(PolicyEffectDeny, actions)
+}
+
+func newPolicyBuilder(effect string, actions []string) *PolicyBuilder {
+	if len(actions) == 0 {
+		actions = []string{RoleActionAll}
+	}
+
+	return &PolicyBuilder{effect: effect, actions: actions}
+}
+
+// Entries restricts the policy to entries
+func (b *PolicyBuilder) Entries() *PolicyBuilder {
+	return b.Where(ConstraintEquals{Doc: ConstraintDocType, Value: "Entry"})
+}
+
+// Assets restricts the policy to assets
+func (b *PolicyBuilder) Assets() *PolicyBuilder {
+	return b.Where(ConstraintEquals{Doc: ConstraintDocType, Value: "Asset"})
+}
+
+// IDs restricts the policy to the entries or assets with the given ids
+func (b *PolicyBuilder) IDs(ids ...string) *PolicyBuilder {
+	return b.Where(equalsOrIn(ConstraintDocID, ids))
+}
+
+// ContentType restricts the policy to entries of the given content types
+func (b *PolicyBuilder) ContentType(contentTypeIds ...string) *PolicyBuilder {
+	return b.Where(equalsOrIn(ConstraintDocContentType, contentTypeIds))
+}
+
+// CreatedByCurrentUser restricts the policy to entries or assets created by the user
+func (b *PolicyBuilder) CreatedByCurrentUser() *PolicyBuilder {
+	return b.Where(ConstraintEquals{Doc: ConstraintDocCreatedBy, Value: ConstraintCurrentUser})
+}
+
+// Tagged restricts the policy to entries or assets with any of the tags
+func (b *PolicyBuilder) Tagged(tagIds ...string) *PolicyBuilder {
+	return b.Where(ConstraintIn{Doc: ConstraintDocTags, Values: tagIds})
+}
+
+// Fields restricts the policy to the fields, in the locales given with Locales
+func (b *PolicyBuilder) Fields(fieldIds ...string) *PolicyBuilder {
+	b.fields = append(b.fields, fieldIds...)
+	return b
+}
+
+// Locales restricts the policy to the locales, of the fields given with Fields
+func (b *PolicyBuilder) Locales(locales ...string) *PolicyBuilder {
+	b.locales = append(b.locales, locales...)
+	return b
+}
+
+// Where adds a constraint, which has to match together with the other constraints
+func (b *PolicyBuilder) Where(constraint Constraint) *PolicyBuilder {
+	b.constraints = append(b.constraints, constraint)
+	return b
+}
+
+// Build returns the policy. Fields and locales are combined into a paths constraint,
+// with a wildcard for the fields or locales when only one of them is given.
+func (b *PolicyBuilder) Build() *RolePolicy {
+	constraints := append(ConstraintAnd{}, b.constraints...)
+
+	if len(b.fields) > 0 || len(b.locales) > 0 {
+		fields, locales := b.fields, b.locales
+		if len(fields) == 0 {
+			fields = []string{ConstraintWildcard}
+		}
+
+		if len(locales) == 0 {
+			locales = []string{ConstraintWildcard}
+		}
+
+		var paths ConstraintPaths
+		for _, field := range fields {
+			for _, locale := range locales {
+				paths = append(paths, "fields."+field+"."+locale)
+			}
+		}
+
+		constraints = append(constraints, paths)
+	}
+
+	return &RolePolicy{
+		Effect:     b.effect,
+		Actions:    append(RoleActions{}, b.actions...),
+		Constraint: constraints,
+	}
+}
+
+func equalsOrIn(doc string, values []string) Constraint {
+	if len(values) == 1 {
+		return ConstraintEquals{Doc: doc, Value: values[0]}
+	}
+
+	return ConstraintIn{Doc: doc, Values: values}
+}
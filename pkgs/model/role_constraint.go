@@ -0,0 +1,225 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// noinspection GoUnusedConst
+const (
+	ConstraintDocType        = "sys.type"
+	ConstraintDocID          = "sys.id"
+	ConstraintDocContentType = "sys.contentType.sys.id"
+	ConstraintDocCreatedBy   = "sys.createdBy.sys.id"
+	ConstraintDocTags        = "metadata.tags.sys.id"
+
+	// ConstraintCurrentUser is compared with sys.createdBy.sys.id to match entities of the current user
+	ConstraintCurrentUser = "User.current()"
+
+	// ConstraintWildcard matches any field or locale in a path, like fields.%.de-DE
+	ConstraintWildcard = "%"
+)
+
+// Constraint is a node of the constraint language of role policies. It is one
+// of ConstraintAnd, ConstraintOr, ConstraintNot, ConstraintEquals, ConstraintIn,
+// ConstraintPaths or, for constraints which are not modelled, ConstraintRaw.
+type Constraint interface {
+	constraint()
+}
+
+// ConstraintAnd matches when all constraints match
+type ConstraintAnd []Constraint
+
+// ConstraintOr matches when any of the constraints matches
+type ConstraintOr []Constraint
+
+// ConstraintNot matches when the constraint does not match
+type ConstraintNot struct {
+	Constraint Constraint
+}
+
+// ConstraintEquals matches when the property at Doc equals the value
+type ConstraintEquals struct {
+	Doc   string
+	Value string
+}
+
+// ConstraintIn matches when the property at Doc equals one of the values
+type ConstraintIn struct {
+	Doc    string
+	Values []string
+}
+
+// ConstraintPaths restricts the policy to the field paths, like fields.title.en-US.
+// Field ids and locales can be ConstraintWildcard.
+type ConstraintPaths []string
+
+// ConstraintRaw keeps a constraint which is not modelled as is
+type ConstraintRaw json.RawMessage
+
+func (ConstraintAnd) constraint()    {}
+func (ConstraintOr) constraint()     {}
+func (ConstraintNot) constraint()    {}
+func (ConstraintEquals) constraint() {}
+func (ConstraintIn) constraint()     {}
+func (ConstraintPaths) constraint()  {}
+func (ConstraintRaw) constraint()    {}
+
+type constraintDoc struct {
+	Doc string `json:"doc"`
+}
+
+// MarshalJSON for custom json marshaling
+func (c ConstraintAnd) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string][]Constraint{"and": nonNil(c)})
+}
+
+// MarshalJSON for custom json marshaling
+func (c ConstraintOr) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string][]Constraint{"or": nonNil(c)})
+}
+
+// MarshalJSON for custom json marshaling
+func (c ConstraintNot) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]Constraint{"not": c.Constraint})
+}
+
+// MarshalJSON for custom json marshaling
+func (c ConstraintEquals) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string][]any{"equals": {constraintDoc{Doc: c.Doc}, c.Value}})
+}
+
+// MarshalJSON for custom json marshaling
+func (c ConstraintIn) MarshalJSON() ([]byte, error) {
+	values := c.Values
+	if values == nil {
+		values = []string{}
+	}
+
+	return json.Marshal(map[string][]any{"in": {constraintDoc{Doc: c.Doc}, values}})
+}
+
+// MarshalJSON for custom json marshaling
+func (c ConstraintPaths) MarshalJSON() ([]byte, error) {
+	docs := make([]constraintDoc, 0, len(c))
+	for _, path := range c {
+		docs = append(docs, constraintDoc{Doc: path})
+	}
+
+	return json.Marshal(map[string][]constraintDoc{"paths": docs})
+}
+
+// MarshalJSON for custom json marshaling
+func (c ConstraintRaw) MarshalJSON() ([]byte, error) {
+	if len(c) == 0 {
+		return []byte("null"), nil
+	}
+
+	return c, nil
+}
+
+func nonNil(constraints []Constraint) []Constraint {
+	if constraints == nil {
+		return []Constraint{}
+	}
+
+	return constraints
+}
+
+// ParseConstraint converts the json representation of a constraint to its typed
+// node. Constraints which are not modelled are returned as ConstraintRaw, so every
+// constraint marshals back to the same json.
+func ParseConstraint(data json.RawMessage) (Constraint, error) {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	raw := ConstraintRaw(bytes.Clone(data))
+
+	if len(payload) != 1 {
+		return raw, nil
+	}
+
+	for operator, operands := range payload {
+		switch operator {
+		case "and", "or":
+			var items []json.RawMessage
+			if err := json.Unmarshal(operands, &items); err != nil {
+				return nil, fmt.Errorf("invalid %s constraint: %w", operator, err)
+			}
+
+			constraints := make([]Constraint, 0, len(items))
+			for _, item := range items {
+				constraint, err := ParseConstraint(item)
+				if err != nil {
+					return nil, err
+				}
+
+				constraints = append(constraints, constraint)
+			}
+
+			if operator == "and" {
+				return ConstraintAnd(constraints), nil
+			}
+
+			return ConstraintOr(constraints), nil
+		case "not":
+			constraint, err := ParseConstraint(operands)
+			if err != nil {
+				return nil, err
+			}
+
+			return ConstraintNot{Constraint: constraint}, nil
+		case "equals":
+			var doc string
+			var value string
+			if !parseComparison(operands, &doc, &value) {
+				return raw, nil
+			}
+
+			return ConstraintEquals{Doc: doc, Value: value}, nil
+		case "in":
+			var doc string
+			var values []string
+			if !parseComparison(operands, &doc, &values) {
+				return raw, nil
+			}
+
+			return ConstraintIn{Doc: doc, Values: values}, nil
+		case "paths":
+			var docs []constraintDoc
+			if err := json.Unmarshal(operands, &docs); err != nil {
+				return raw, nil
+			}
+
+			paths := make(ConstraintPaths, 0, len(docs))
+			for _, doc := range docs {
+				paths = append(paths, doc.Doc)
+			}
+
+			return paths, nil
+		}
+	}
+
+	return raw, nil
+}
+
+// parseComparison parses the [{"doc": path}, value] operands of equals and in,
+// and reports whether they have the expected types
+func parseComparison(data json.RawMessage, doc *string, value any) bool {
+	var operands []json.RawMessage
+	if err := json.Unmarshal(data, &operands); err != nil || len(operands) != 2 {
+		return false
+	}
+
+	var path map[string]string
+	if err := json.Unmarshal(operands[0], &path); err != nil || len(path) != 1 || path["doc"] == "" {
+		return false
+	}
+
+	*doc = path["doc"]
+
+	return string(operands[1]) != "null" && json.Unmarshal(operands[1], value) == nil
+}
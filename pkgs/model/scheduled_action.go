@@ -0,0 +1,173 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+type ScheduledActionStatus string
+
+const (
+	ScheduledActionStatusScheduled ScheduledActionStatus = "scheduled"
+	ScheduledActionStatusSucceeded ScheduledActionStatus = "succeeded"
+	ScheduledActionStatusFailed    ScheduledActionStatus = "failed"
+	ScheduledActionStatusCanceled  ScheduledActionStatus = "canceled"
+)
+
+type ScheduledActionType string
+
+const (
+	ScheduledActionPublish   ScheduledActionType = "publish"
+	ScheduledActionUnpublish ScheduledActionType = "unpublish"
+)
+
+// noinspection GoUnusedConst
+const (
+	ScheduledEntityEntry   = "Entry"
+	ScheduledEntityAsset   = "Asset"
+	ScheduledEntityRelease = "Release"
+)
+
+type ScheduledActionSys struct {
+	SpaceSys
+	Status     ScheduledActionStatus `json:"status,omitempty"`
+	CanceledAt string                `json:"canceledAt,omitempty"`
+	CanceledBy *struct {
+		Sys BaseSys `json:"sys,omitempty"`
+	} `json:"canceledBy,omitempty"`
+}
+
+// ScheduledAction model
+type ScheduledAction struct {
+	Sys          *ScheduledActionSys `json:"sys,omitempty"`
+	Entity       ScheduledEntity     `json:"entity"`
+	Environment  ScheduledEntity     `json:"environment"`
+	ScheduledFor ScheduledFor        `json:"scheduledFor"`
+	Action       ScheduledActionType `json:"action"`
+}
+
+// ScheduledEntity is a link to the entity or environment of a scheduled action
+type ScheduledEntity struct {
+	Sys BaseSys `json:"sys"`
+}
+
+// ScheduledFor holds the moment an action is executed and the IANA timezone
+// it was scheduled in
+type ScheduledFor struct {
+	Datetime time.Time `json:"datetime"`
+	Timezone string    `json:"timezone,omitempty"`
+}
+
+// NewScheduledFor returns the given moment expressed in the given IANA timezone
+func NewScheduledFor(datetime time.Time, timezone string) (ScheduledFor, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return ScheduledFor{}, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+
+	return ScheduledFor{
+		Datetime: datetime.In(location),
+		Timezone: timezone,
+	}, nil
+}
+
+// Local returns the scheduled moment in its timezone, or as is when no timezone is set
+func (s ScheduledFor) Local() (time.Time, error) {
+	if s.Timezone == "" {
+		return s.Datetime, nil
+	}
+
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return s.Datetime.In(location), nil
+}
+
+// NewScheduledAction creates a scheduled action for the given entity link type (Entry, Asset or Release)
+func NewScheduledAction(environment, linkType, entityId string, action ScheduledActionType, scheduledFor ScheduledFor) *ScheduledAction {
+	return &ScheduledAction{
+		Entity: ScheduledEntity{
+			Sys: BaseSys{
+				ID:       entityId,
+				Type:     "Link",
+				LinkType: linkType,
+			},
+		},
+		Environment: ScheduledEntity{
+			Sys: BaseSys{
+				ID:       environment,
+				Type:     "Link",
+				LinkType: "Environment",
+			},
+		},
+		ScheduledFor: scheduledFor,
+		Action:       action,
+	}
+}
+
+// GetVersion returns entity version
+func (s *ScheduledAction) GetVersion() int {
+	version := 1
+	if s.Sys != nil {
+		version = s.Sys.Version
+	}
+
+	return version
+}
+
+func (s *ScheduledAction) IsNew() bool {
+	return s.Sys == nil || s.Sys.ID == ""
+}
+
+func (s *ScheduledAction) IsScheduled() bool {
+	return s.Sys == nil || s.Sys.Status == "" || s.Sys.Status == ScheduledActionStatusScheduled
+}
+
+func (s *ScheduledAction) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&s)
+}
+
+// ConflictsWith reports whether both actions target the same entity, are both
+// still pending and are executed within the given window of each other
+func (s *ScheduledAction) ConflictsWith(other *ScheduledAction, window time.Duration) bool {
+	if other == nil || s == other {
+		return false
+	}
+
+	if !s.IsNew() && !other.IsNew() && s.Sys.ID == other.Sys.ID {
+		return false
+	}
+
+	if s.Entity.Sys.ID != other.Entity.Sys.ID || s.Entity.Sys.LinkType != other.Entity.Sys.LinkType {
+		return false
+	}
+
+	if !s.IsScheduled() || !other.IsScheduled() {
+		return false
+	}
+
+	diff := s.ScheduledFor.Datetime.Sub(other.ScheduledFor.Datetime)
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return diff <= window
+}
+
+// FindScheduledActionConflicts returns all existing actions conflicting with the given action
+func FindScheduledActionConflicts(action *ScheduledAction, existing []*ScheduledAction, window time.Duration) []*ScheduledAction {
+	var conflicts []*ScheduledAction
+
+	for _, other := range existing {
+		if action.ConflictsWith(other, window) {
+			conflicts = append(conflicts, other)
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// noinspection GoUnusedConst
+const (
+	SnapshotTypePublish = "publish"
+)
+
+type SnapshotSys struct {
+	SpaceSys
+	SnapshotType       string `json:"snapshotType,omitempty"`
+	SnapshotEntityType string `json:"snapshotEntityType,omitempty"`
+}
+
+// EntrySnapshot is the state of an entry when it was published
+type EntrySnapshot struct {
+	Sys      *SnapshotSys `json:"sys"`
+	Snapshot *Entry       `json:"snapshot"`
+}
+
+// ContentTypeSnapshot is the state of a content type when it was activated
+type ContentTypeSnapshot struct {
+	Sys      *SnapshotSys `json:"sys"`
+	Snapshot *ContentType `json:"snapshot"`
+}
+
+// Diff returns the changes from the snapshot to the other snapshot
+func (s *EntrySnapshot) Diff(other *EntrySnapshot) []*FieldChange {
+	return DiffEntries(s.Snapshot, other.Snapshot)
+}
+
+// DiffEntry returns the changes from the snapshot to the entry, usually its current version
+func (s *EntrySnapshot) DiffEntry(entry *Entry) []*FieldChange {
+	return DiffEntries(s.Snapshot, entry)
+}
+
+func (s *EntrySnapshot) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&s)
+}
+
+func (s *ContentTypeSnapshot) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&s)
+}
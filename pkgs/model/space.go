@@ -0,0 +1,77 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// DefaultLocale is the default locale of new spaces, unless another locale is given
+const DefaultLocale = "en-US"
+
+// Space model. DefaultLocale is only used when the space is created.
+type Space struct {
+	Sys           *OrganizationSys `json:"sys,omitempty"`
+	Name          string           `json:"name"`
+	DefaultLocale string           `json:"defaultLocale,omitempty"`
+}
+
+// NewSpace returns a space to create in the organization, with the default locale
+// or DefaultLocale when it is empty
+func NewSpace(organizationId string, name string, defaultLocale string) *Space {
+	if defaultLocale == "" {
+		defaultLocale = DefaultLocale
+	}
+
+	organization := NewLink("Organization", organizationId)
+
+	return &Space{
+		Sys:           &OrganizationSys{Organization: &organization},
+		Name:          name,
+		DefaultLocale: defaultLocale,
+	}
+}
+
+// MarshalJSON for custom json marshaling
+func (s *Space) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Name          string `json:"name"`
+		DefaultLocale string `json:"defaultLocale,omitempty"`
+	}{
+		Name:          s.Name,
+		DefaultLocale: s.DefaultLocale,
+	})
+}
+
+// OrganizationId returns the id of the organization of the space
+func (s *Space) OrganizationId() string {
+	if s.Sys == nil || s.Sys.Organization == nil {
+		return ""
+	}
+
+	return s.Sys.Organization.Sys.ID
+}
+
+// GetVersion returns entity version
+func (s *Space) GetVersion() int {
+	version := 1
+	if s.Sys != nil {
+		version = s.Sys.Version
+	}
+
+	return version
+}
+
+func (s *Space) IsNew() bool {
+	return s.Sys == nil || s.Sys.ID == ""
+}
+
+func (s *Space) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&s)
+}
+
+// Organization model
+type Organization struct {
+	Sys  *CreatedSys `json:"sys,omitempty"`
+	Name string      `json:"name"`
+}
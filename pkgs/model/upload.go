@@ -0,0 +1,32 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type UploadSys struct {
+	SpaceSys
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
+
+// Upload model
+type Upload struct {
+	Sys *UploadSys `json:"sys"`
+}
+
+// UploadFrom returns the link to use as File.UploadFrom of an asset
+func (u *Upload) UploadFrom() *UploadFrom {
+	return &UploadFrom{
+		Sys: &BaseSys{
+			ID:       u.Sys.ID,
+			Type:     "Link",
+			LinkType: "Upload",
+		},
+	}
+}
+
+func (u *Upload) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&u)
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// User model, a user of the organization
+type User struct {
+	Sys         *CreatedSys `json:"sys,omitempty"`
+	FirstName   string      `json:"firstName,omitempty"`
+	LastName    string      `json:"lastName,omitempty"`
+	AvatarURL   string      `json:"avatarUrl,omitempty"`
+	Email       string      `json:"email"`
+	Activated   bool        `json:"activated,omitempty"`
+	SignInCount int         `json:"signInCount,omitempty"`
+	Confirmed   bool        `json:"confirmed,omitempty"`
+}
+
+func (u *User) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&u)
+}
@@ -0,0 +1,309 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+)
+
+// WebhookTopic is a Type.Action combination a webhook is triggered for, like Entry.publish or *.*
+type WebhookTopic string
+
+// Webhook model
+type Webhook struct {
+	Sys               *SpaceSys              `json:"sys,omitempty"`
+	Name              string                 `json:"name,omitempty"`
+	URL               string                 `json:"url,omitempty"`
+	Topics            []WebhookTopic         `json:"topics,omitempty"`
+	Filters           []*WebhookFilter       `json:"filters,omitempty"`
+	HTTPBasicUsername string                 `json:"httpBasicUsername,omitempty"`
+	HTTPBasicPassword string                 `json:"httpBasicPassword,omitempty"`
+	Headers           []*WebhookHeader       `json:"headers,omitempty"`
+	Transformation    *WebhookTransformation `json:"transformation,omitempty"`
+	// Active defaults to true when not set
+	Active *bool `json:"active,omitempty"`
+}
+
+// WebhookHeader model, secret headers are returned without value
+type WebhookHeader struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Secret bool   `json:"secret,omitempty"`
+}
+
+// WebhookTransformation changes the request sent by a webhook
+type WebhookTransformation struct {
+	Method               string `json:"method,omitempty"`
+	ContentType          string `json:"contentType,omitempty"`
+	IncludeContentLength *bool  `json:"includeContentLength,omitempty"`
+	// Body is a JSON template, kept as is
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// noinspection GoUnusedConst
+const (
+	WebhookContentTypeManagement         = "application/vnd.contentful.management.v1+json"
+	WebhookContentTypeManagementUTF8     = "application/vnd.contentful.management.v1+json; charset=utf-8"
+	WebhookContentTypeJSON               = "application/json"
+	WebhookContentTypeJSONUTF8           = "application/json; charset=utf-8"
+	WebhookContentTypeFormURLEncoded     = "application/x-www-form-urlencoded"
+	WebhookContentTypeFormURLEncodedUTF8 = "application/x-www-form-urlencoded; charset=utf-8"
+)
+
+var webhookContentTypes = []string{
+	WebhookContentTypeManagement,
+	WebhookContentTypeManagementUTF8,
+	WebhookContentTypeJSON,
+	WebhookContentTypeJSONUTF8,
+	WebhookContentTypeFormURLEncoded,
+	WebhookContentTypeFormURLEncodedUTF8,
+}
+
+var webhookMethods = []string{
+	http.MethodPost,
+	http.MethodGet,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// Validate checks the method, content type and body template of the transformation
+func (t *WebhookTransformation) Validate() error {
+	if t.Method != "" && !slices.Contains(webhookMethods, t.Method) {
+		return fmt.Errorf("unsupported webhook method %s", t.Method)
+	}
+
+	if t.ContentType != "" && !slices.Contains(webhookContentTypes, t.ContentType) {
+		return fmt.Errorf("unsupported webhook content type %s", t.ContentType)
+	}
+
+	if len(t.Body) > 0 && !json.Valid(t.Body) {
+		return errors.New("webhook body template is not valid JSON")
+	}
+
+	return nil
+}
+
+// GetVersion returns entity version
+func (w *Webhook) GetVersion() int {
+	version := 1
+	if w.Sys != nil {
+		version = w.Sys.Version
+	}
+
+	return version
+}
+
+func (w *Webhook) IsNew() bool {
+	return w.Sys == nil || w.Sys.ID == ""
+}
+
+// IsActive reports whether the webhook is called, webhooks are active unless disabled
+func (w *Webhook) IsActive() bool {
+	return w.Active == nil || *w.Active
+}
+
+// Validate checks the topics, filters and transformation of the webhook
+func (w *Webhook) Validate() error {
+	var errs []error
+
+	for _, topic := range w.Topics {
+		if err := topic.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, filter := range w.Filters {
+		if err := filter.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if w.Transformation != nil {
+		if err := w.Transformation.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (w *Webhook) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&w)
+}
+
+type WebhookFilterOperator string
+
+// noinspection GoUnusedConst
+const (
+	WebhookFilterEquals WebhookFilterOperator = "equals"
+	WebhookFilterIn     WebhookFilterOperator = "in"
+	WebhookFilterRegexp WebhookFilterOperator = "regexp"
+)
+
+// WebhookFilter restricts the entities a webhook is triggered for. Depending on the
+// operator the property at Doc, like sys.environment.sys.id, is compared with Value,
+// Values or Pattern. Not negates the filter.
+type WebhookFilter struct {
+	Operator WebhookFilterOperator
+	Doc      string
+	Value    string
+	Values   []string
+	Pattern  string
+	Not      bool
+}
+
+type webhookFilterDoc struct {
+	Doc string `json:"doc"`
+}
+
+type webhookFilterPattern struct {
+	Pattern string `json:"pattern"`
+}
+
+// MarshalJSON for custom json marshaling
+func (f *WebhookFilter) MarshalJSON() ([]byte, error) {
+	var operand any
+
+	switch f.Operator {
+	case WebhookFilterEquals:
+		operand = f.Value
+	case WebhookFilterIn:
+		values := f.Values
+		if values == nil {
+			values = []string{}
+		}
+
+		operand = values
+	case WebhookFilterRegexp:
+		operand = webhookFilterPattern{Pattern: f.Pattern}
+	default:
+		return nil, fmt.Errorf("unknown webhook filter operator %q", f.Operator)
+	}
+
+	constraint := map[WebhookFilterOperator][]any{
+		f.Operator: {webhookFilterDoc{Doc: f.Doc}, operand},
+	}
+
+	if f.Not {
+		return json.Marshal(map[string]any{"not": constraint})
+	}
+
+	return json.Marshal(constraint)
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (f *WebhookFilter) UnmarshalJSON(data []byte) error {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	*f = WebhookFilter{}
+
+	if negated, ok := payload["not"]; ok {
+		f.Not = true
+
+		payload = nil
+		if err := json.Unmarshal(negated, &payload); err != nil {
+			return err
+		}
+	}
+
+	if len(payload) != 1 {
+		return errors.New("webhook filter must have exactly one operator")
+	}
+
+	for operator, raw := range payload {
+		f.Operator = WebhookFilterOperator(operator)
+
+		var operands []json.RawMessage
+		if err := json.Unmarshal(raw, &operands); err != nil {
+			return err
+		}
+
+		if len(operands) != 2 {
+			return fmt.Errorf("webhook filter %s must have two operands", operator)
+		}
+
+		var doc webhookFilterDoc
+		if err := json.Unmarshal(operands[0], &doc); err != nil {
+			return err
+		}
+
+		f.Doc = doc.Doc
+
+		switch f.Operator {
+		case WebhookFilterEquals:
+			return json.Unmarshal(operands[1], &f.Value)
+		case WebhookFilterIn:
+			return json.Unmarshal(operands[1], &f.Values)
+		case WebhookFilterRegexp:
+			var pattern webhookFilterPattern
+			if err := json.Unmarshal(operands[1], &pattern); err != nil {
+				return err
+			}
+
+			f.Pattern = pattern.Pattern
+		default:
+			return fmt.Errorf("unknown webhook filter operator %q", operator)
+		}
+	}
+
+	return nil
+}
+
+// WebhookCall model, the request and response are only set when getting a single call
+type WebhookCall struct {
+	Sys        *SpaceSys            `json:"sys,omitempty"`
+	Request    *WebhookCallRequest  `json:"request,omitempty"`
+	Response   *WebhookCallResponse `json:"response,omitempty"`
+	StatusCode int                  `json:"statusCode"`
+	Errors     []string             `json:"errors"`
+	EventType  string               `json:"eventType"`
+	URL        string               `json:"url"`
+	RequestAt  string               `json:"requestAt"`
+	ResponseAt string               `json:"responseAt"`
+}
+
+type WebhookCallRequest struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method"`
+	Headers map[string]string `json:"headers"`
+	Body    string            `json:"body"`
+}
+
+type WebhookCallResponse struct {
+	URL        string            `json:"url"`
+	Headers    map[string]string `json:"headers"`
+	Body       string            `json:"body"`
+	StatusCode int               `json:"statusCode"`
+}
+
+// Succeeded reports whether the call was answered with a 2xx status code
+func (c *WebhookCall) Succeeded() bool {
+	return c.StatusCode >= 200 && c.StatusCode < 300 && len(c.Errors) == 0
+}
+
+func (c *WebhookCall) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&c)
+}
+
+// WebhookHealth model
+type WebhookHealth struct {
+	Sys   *SpaceSys `json:"sys,omitempty"`
+	Calls struct {
+		Total   int `json:"total"`
+		Healthy int `json:"healthy"`
+	} `json:"calls"`
+}
+
+func (h *WebhookHealth) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&h)
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// ErrInvalidWebhookFilter is returned for filters Contentful does not accept
+var ErrInvalidWebhookFilter = errors.New("invalid webhook filter")
+
+// noinspection GoUnusedConst
+const (
+	WebhookFilterDocID          = "sys.id"
+	WebhookFilterDocEnvironment = "sys.environment.sys.id"
+	WebhookFilterDocContentType = "sys.contentType.sys.id"
+	WebhookFilterDocCreatedBy   = "sys.createdBy.sys.id"
+	WebhookFilterDocUpdatedBy   = "sys.updatedBy.sys.id"
+)
+
+var webhookFilterDocs = []string{
+	WebhookFilterDocID,
+	WebhookFilterDocEnvironment,
+	WebhookFilterDocContentType,
+	WebhookFilterDocCreatedBy,
+	WebhookFilterDocUpdatedBy,
+}
+
+// Validate checks the path of the filter and the value for its operator
+func (f *WebhookFilter) Validate() error {
+	if !slices.Contains(webhookFilterDocs, f.Doc) {
+		return fmt.Errorf("%w: unsupported path %q", ErrInvalidWebhookFilter, f.Doc)
+	}
+
+	switch f.Operator {
+	case WebhookFilterEquals:
+		if f.Value == "" {
+			return fmt.Errorf("%w: equals on %s without value", ErrInvalidWebhookFilter, f.Doc)
+		}
+	case WebhookFilterIn:
+		if len(f.Values) == 0 {
+			return fmt.Errorf("%w: in on %s without values", ErrInvalidWebhookFilter, f.Doc)
+		}
+	case WebhookFilterRegexp:
+		if _, err := regexp.Compile(f.Pattern); err != nil || f.Pattern == "" {
+			return fmt.Errorf("%w: invalid pattern %q on %s", ErrInvalidWebhookFilter, f.Pattern, f.Doc)
+		}
+	default:
+		return fmt.Errorf("%w: unknown operator %q", ErrInvalidWebhookFilter, f.Operator)
+	}
+
+	return nil
+}
+
+// WebhookFiltersBuilder collects the filters of a webhook, which all have to match
+// for the webhook to be triggered
+type WebhookFiltersBuilder struct {
+	filters []*WebhookFilter
+}
+
+func NewWebhookFiltersBuilder() *WebhookFiltersBuilder {
+	return &WebhookFiltersBuilder{}
+}
+
+func (b *WebhookFiltersBuilder) add(filter *WebhookFilter) *WebhookFiltersBuilder {
+	b.filters = append(b.filters, filter)
+	return b
+}
+
+// Equals matches when the property at the path equals the value
+func (b *WebhookFiltersBuilder) Equals(doc string, value string) *WebhookFiltersBuilder {
+	return b.add(&WebhookFilter{Operator: WebhookFilterEquals, Doc: doc, Value: value})
+}
+
+// NotEquals matches when the property at the path does not equal the value
+func (b *WebhookFiltersBuilder) NotEquals(doc string, value string) *WebhookFiltersBuilder {
+	return b.add(&WebhookFilter{Operator: WebhookFilterEquals, Doc: doc, Value: value, Not: true})
+}
+
+// In matches when the property at the path equals one of the values
+func (b *WebhookFiltersBuilder) In(doc string, values ...string) *WebhookFiltersBuilder {
+	return b.add(&WebhookFilter{Operator: WebhookFilterIn, Doc: doc, Values: values})
+}
+
+// NotIn matches when the property at the path equals none of the values
+func (b *WebhookFiltersBuilder) NotIn(doc string, values ...string) *WebhookFiltersBuilder {
+	return b.add(&WebhookFilter{Operator: WebhookFilterIn, Doc: doc, Values: values, Not: true})
+}
+
+// Regexp matches when the property at the path matches the pattern
+func (b *WebhookFiltersBuilder) Regexp(doc string, pattern string) *WebhookFiltersBuilder {
+	return b.add(&WebhookFilter{Operator: WebhookFilterRegexp, Doc: doc, Pattern: pattern})
+}
+
+// NotRegexp matches when the property at the path does not match the pattern
+func (b *WebhookFiltersBuilder) NotRegexp(doc string, pattern string) *WebhookFiltersBuilder {
+	return b.add(&WebhookFilter{Operator: WebhookFilterRegexp, Doc: doc, Pattern: pattern, Not: true})
+}
+
+// Environment matches entities of the given environments
+func (b *WebhookFiltersBuilder) Environment(environmentIds ...string) *WebhookFiltersBuilder {
+	if len(environmentIds) == 1 {
+		return b.Equals(WebhookFilterDocEnvironment, environmentIds[0])
+	}
+
+	return b.In(WebhookFilterDocEnvironment, environmentIds...)
+}
+
+// ContentType matches entries of the given content types
+func (b *WebhookFiltersBuilder) ContentType(contentTypeIds ...string) *WebhookFiltersBuilder {
+	if len(contentTypeIds) == 1 {
+		return b.Equals(WebhookFilterDocContentType, contentTypeIds[0])
+	}
+
+	return b.In(WebhookFilterDocContentType, contentTypeIds...)
+}
+
+// Build validates the filters and returns them
+func (b *WebhookFiltersBuilder) Build() ([]*WebhookFilter, error) {
+	var errs []error
+
+	for _, filter := range b.filters {
+		if err := filter.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return b.filters, nil
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// WebhookSigningSecret model. Value is only known right after the secret is
+// created or rotated, the API only returns the last characters as RedactedValue.
+type WebhookSigningSecret struct {
+	Sys           *SpaceSys `json:"sys,omitempty"`
+	Value         string    `json:"value,omitempty"`
+	RedactedValue string    `json:"redactedValue,omitempty"`
+}
+
+func (s *WebhookSigningSecret) Decode(body io.ReadCloser) error {
+	defer body.Close()
+	return json.NewDecoder(body).Decode(&s)
+}
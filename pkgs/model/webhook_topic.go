@@ -0,0 +1,160 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidWebhookTopic is returned for topics Contentful does not trigger webhooks for
+var ErrInvalidWebhookTopic = errors.New("invalid webhook topic")
+
+type WebhookTopicType string
+
+type WebhookTopicAction string
+
+// noinspection GoUnusedConst
+const (
+	WebhookTypeAll             WebhookTopicType = "*"
+	WebhookTypeEntry           WebhookTopicType = "Entry"
+	WebhookTypeAsset           WebhookTopicType = "Asset"
+	WebhookTypeContentType     WebhookTopicType = "ContentType"
+	WebhookTypeTask            WebhookTopicType = "Task"
+	WebhookTypeComment         WebhookTopicType = "Comment"
+	WebhookTypeRelease         WebhookTopicType = "Release"
+	WebhookTypeReleaseAction   WebhookTopicType = "ReleaseAction"
+	WebhookTypeBulkAction      WebhookTopicType = "BulkAction"
+	WebhookTypeScheduledAction WebhookTopicType = "ScheduledAction"
+)
+
+// noinspection GoUnusedConst
+const (
+	WebhookActionAll       WebhookTopicAction = "*"
+	WebhookActionCreate    WebhookTopicAction = "create"
+	WebhookActionSave      WebhookTopicAction = "save"
+	WebhookActionAutoSave  WebhookTopicAction = "auto_save"
+	WebhookActionArchive   WebhookTopicAction = "archive"
+	WebhookActionUnarchive WebhookTopicAction = "unarchive"
+	WebhookActionPublish   WebhookTopicAction = "publish"
+	WebhookActionUnpublish WebhookTopicAction = "unpublish"
+	WebhookActionDelete    WebhookTopicAction = "delete"
+	WebhookActionExecute   WebhookTopicAction = "execute"
+)
+
+// WebhookTopicAll triggers a webhook for every action on every type
+const WebhookTopicAll WebhookTopic = "*.*"
+
+// webhookTopicActions holds the actions Contentful triggers webhooks for, per type
+var webhookTopicActions = map[WebhookTopicType][]WebhookTopicAction{
+	WebhookTypeEntry:           {WebhookActionCreate, WebhookActionSave, WebhookActionAutoSave, WebhookActionArchive, WebhookActionUnarchive, WebhookActionPublish, WebhookActionUnpublish, WebhookActionDelete},
+	WebhookTypeAsset:           {WebhookActionCreate, WebhookActionSave, WebhookActionAutoSave, WebhookActionArchive, WebhookActionUnarchive, WebhookActionPublish, WebhookActionUnpublish, WebhookActionDelete},
+	WebhookTypeContentType:     {WebhookActionCreate, WebhookActionSave, WebhookActionPublish, WebhookActionUnpublish, WebhookActionDelete},
+	WebhookTypeTask:            {WebhookActionCreate, WebhookActionSave, WebhookActionDelete},
+	WebhookTypeComment:         {WebhookActionCreate, WebhookActionDelete},
+	WebhookTypeRelease:         {WebhookActionCreate, WebhookActionSave, WebhookActionArchive, WebhookActionUnarchive, WebhookActionDelete},
+	WebhookTypeReleaseAction:   {WebhookActionCreate, WebhookActionExecute},
+	WebhookTypeBulkAction:      {WebhookActionCreate, WebhookActionExecute},
+	WebhookTypeScheduledAction: {WebhookActionCreate, WebhookActionSave, WebhookActionExecute, WebhookActionDelete},
+}
+
+// NewWebhookTopic returns the topic for the type and action, either can be a wildcard
+func NewWebhookTopic(topicType WebhookTopicType, action WebhookTopicAction) WebhookTopic {
+	return WebhookTopic(string(topicType) + "." + string(action))
+}
+
+// Type returns the entity type of the topic, like Entry
+func (t WebhookTopic) Type() WebhookTopicType {
+	topicType, _, _ := strings.Cut(string(t), ".")
+	return WebhookTopicType(topicType)
+}
+
+// Action returns the action of the topic, like publish
+func (t WebhookTopic) Action() WebhookTopicAction {
+	_, action, _ := strings.Cut(string(t), ".")
+	return WebhookTopicAction(action)
+}
+
+// Validate checks whether Contentful triggers webhooks for the topic
+func (t WebhookTopic) Validate() error {
+	topicType, action, ok := strings.Cut(string(t), ".")
+	if !ok || topicType == "" || action == "" || strings.Contains(action, ".") {
+		return fmt.Errorf("%w: %q is not of the form Type.action", ErrInvalidWebhookTopic, t)
+	}
+
+	if WebhookTopicType(topicType) == WebhookTypeAll {
+		if WebhookTopicAction(action) == WebhookActionAll {
+			return nil
+		}
+
+		for _, actions := range webhookTopicActions {
+			if slices.Contains(actions, WebhookTopicAction(action)) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%w: %q has unknown action %s", ErrInvalidWebhookTopic, t, action)
+	}
+
+	actions, ok := webhookTopicActions[WebhookTopicType(topicType)]
+	if !ok {
+		return fmt.Errorf("%w: %q has unknown type %s", ErrInvalidWebhookTopic, t, topicType)
+	}
+
+	if WebhookTopicAction(action) != WebhookActionAll && !slices.Contains(actions, WebhookTopicAction(action)) {
+		return fmt.Errorf("%w: %s has no action %s", ErrInvalidWebhookTopic, topicType, action)
+	}
+
+	return nil
+}
+
+// WebhookTopicsBuilder collects the topics of a webhook
+type WebhookTopicsBuilder struct {
+	topics []WebhookTopic
+}
+
+func NewWebhookTopicsBuilder() *WebhookTopicsBuilder {
+	return &WebhookTopicsBuilder{}
+}
+
+// Add adds a topic for each action of the type, or the type wildcard when no actions are given
+func (b *WebhookTopicsBuilder) Add(topicType WebhookTopicType, actions ...WebhookTopicAction) *WebhookTopicsBuilder {
+	if len(actions) == 0 {
+		actions = []WebhookTopicAction{WebhookActionAll}
+	}
+
+	for _, action := range actions {
+		b.topics = append(b.topics, NewWebhookTopic(topicType, action))
+	}
+
+	return b
+}
+
+// Topic adds the given topics as they are
+func (b *WebhookTopicsBuilder) Topic(topics ...WebhookTopic) *WebhookTopicsBuilder {
+	b.topics = append(b.topics, topics...)
+	return b
+}
+
+// Build validates the topics and returns them without duplicates, in order of addition
+func (b *WebhookTopicsBuilder) Build() ([]WebhookTopic, error) {
+	var errs []error
+	topics := make([]WebhookTopic, 0, len(b.topics))
+
+	for _, topic := range b.topics {
+		if err := topic.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if !slices.Contains(topics, topic) {
+			topics = append(topics, topic)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return topics, nil
+}
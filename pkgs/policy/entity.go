@@ -0,0 +1,157 @@
+package policy
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+// Entity is an entry or asset a permission is checked for, optionally narrowed
+// down to a field in a locale. Entities are values, every method returns a copy.
+type Entity struct {
+	sys    map[string]any
+	tags   []string
+	field  string
+	locale string
+}
+
+// Entry returns the entity for the entry
+func Entry(entry *model.Entry) Entity {
+	return fromSys("Entry", entry.Sys)
+}
+
+// Asset returns the entity for the asset
+func Asset(asset *model.Asset) Entity {
+	return fromSys("Asset", asset.Sys)
+}
+
+// NewEntry returns an entity for an entry of the content type which is not loaded,
+// for example to generate permission matrices
+func NewEntry(id string, contentTypeId string) Entity {
+	return Entity{sys: map[string]any{
+		"type": "Entry",
+		"id":   id,
+		"contentType": map[string]any{
+			"sys": map[string]any{"id": contentTypeId},
+		},
+	}}
+}
+
+// NewAsset returns an entity for an asset which is not loaded
+func NewAsset(id string) Entity {
+	return Entity{sys: map[string]any{
+		"type": "Asset",
+		"id":   id,
+	}}
+}
+
+func fromSys(entityType string, sys *model.PublishSys) Entity {
+	entity := Entity{sys: map[string]any{}}
+
+	if sys != nil {
+		data, err := json.Marshal(sys)
+		if err == nil {
+			_ = json.Unmarshal(data, &entity.sys)
+		}
+	}
+
+	// sys.type is not always set on entities created locally
+	entity.sys["type"] = entityType
+
+	return entity
+}
+
+// Field narrows the entity down to the field in the locale
+func (e Entity) Field(fieldId string, locale string) Entity {
+	e.field, e.locale = fieldId, locale
+	return e
+}
+
+// CreatedBy sets the id of the user who created the entity
+func (e Entity) CreatedBy(userId string) Entity {
+	e.sys = clone(e.sys)
+	e.sys["createdBy"] = map[string]any{"sys": map[string]any{"id": userId}}
+
+	return e
+}
+
+// Tags sets the ids of the tags of the entity, which are not part of the models
+func (e Entity) Tags(tagIds ...string) Entity {
+	e.tags = tagIds
+	return e
+}
+
+// Type returns Entry or Asset
+func (e Entity) Type() string {
+	value, _ := e.sys["type"].(string)
+	return value
+}
+
+// String describes the entity, like Entry blogPost/hello fields.title.de-DE
+func (e Entity) String() string {
+	var builder strings.Builder
+
+	builder.WriteString(e.Type())
+	builder.WriteString(" ")
+
+	if contentType, ok := e.lookup(model.ConstraintDocContentType); ok {
+		builder.WriteString(stringValue(contentType))
+		builder.WriteString("/")
+	}
+
+	id, _ := e.sys["id"].(string)
+	builder.WriteString(id)
+
+	if e.field != "" {
+		builder.WriteString(" fields." + e.field + "." + e.locale)
+	}
+
+	return builder.String()
+}
+
+// lookup returns the value at the doc path, like sys.contentType.sys.id
+func (e Entity) lookup(doc string) (any, bool) {
+	if doc == model.ConstraintDocTags {
+		values := make([]any, 0, len(e.tags))
+		for _, tag := range e.tags {
+			values = append(values, tag)
+		}
+
+		return values, true
+	}
+
+	segments := strings.Split(doc, ".")
+	if segments[0] != "sys" {
+		return nil, false
+	}
+
+	var value any = e.sys
+	for _, segment := range segments[1:] {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = nested[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+func clone(values map[string]any) map[string]any {
+	result := make(map[string]any, len(values))
+	for key, value := range values {
+		result[key] = value
+	}
+
+	return result
+}
+
+func stringValue(value any) string {
+	text, _ := value.(string)
+	return text
+}
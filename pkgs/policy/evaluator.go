@@ -0,0 +1,193 @@
+// Package policy evaluates the policies and permissions of a role locally, to test
+// what a custom role allows without signing in as an editor.
+package policy
+
+import (
+	"strings"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type Options struct {
+	// UserID is the user User.current() constraints are compared with
+	UserID string
+}
+
+// Evaluator answers what the role allows. Like Contentful, an action is allowed
+// when an allow policy matches and no deny policy matches.
+type Evaluator struct {
+	role   *model.Role
+	userID string
+}
+
+func New(role *model.Role, options *Options) *Evaluator {
+	if options == nil {
+		options = &Options{}
+	}
+
+	return &Evaluator{
+		role:   role,
+		userID: options.UserID,
+	}
+}
+
+// Can reports whether the action is allowed on the entity. For an entity without
+// field the entity as a whole is checked: allow policies restricted to paths grant
+// access to it, since some fields can be changed, and deny policies restricted to
+// paths do not deny it. For an entity narrowed down to a field the paths apply.
+func (e *Evaluator) Can(action string, entity Entity) bool {
+	allowed := false
+
+	for _, policy := range e.role.Policies {
+		if !policy.Actions.Contains(action) {
+			continue
+		}
+
+		deny := policy.Effect == model.PolicyEffectDeny
+
+		if !e.matches(policy.Constraint, entity, deny) {
+			continue
+		}
+
+		if deny {
+			return false
+		}
+
+		allowed = true
+	}
+
+	return allowed
+}
+
+// HasPermission reports whether the role has the permission, like PermissionContentModel,
+// for the action, like manage
+func (e *Evaluator) HasPermission(permission string, action string) bool {
+	return e.role.Permissions[permission].Contains(action)
+}
+
+// result of a constraint, which is unknown for constraints the evaluator does not model
+type result int
+
+const (
+	noMatch result = iota
+	match
+	unknown
+)
+
+func matchIf(ok bool) result {
+	if ok {
+		return match
+	}
+
+	return noMatch
+}
+
+// matches evaluates the constraint for the entity. Unknown constraints never match,
+// so they do not grant access and do not deny it. This also holds for negated and
+// combined unknown constraints, unless the outcome does not depend on them.
+func (e *Evaluator) matches(constraint model.Constraint, entity Entity, deny bool) bool {
+	return e.evaluate(constraint, entity, deny) == match
+}
+
+func (e *Evaluator) evaluate(constraint model.Constraint, entity Entity, deny bool) result {
+	switch typed := constraint.(type) {
+	case nil:
+		return match
+	case model.ConstraintAnd:
+		outcome := match
+
+		for _, item := range typed {
+			switch e.evaluate(item, entity, deny) {
+			case noMatch:
+				return noMatch
+			case unknown:
+				outcome = unknown
+			}
+		}
+
+		return outcome
+	case model.ConstraintOr:
+		outcome := noMatch
+
+		for _, item := range typed {
+			switch e.evaluate(item, entity, deny) {
+			case match:
+				return match
+			case unknown:
+				outcome = unknown
+			}
+		}
+
+		return outcome
+	case model.ConstraintNot:
+		switch e.evaluate(typed.Constraint, entity, !deny) {
+		case match:
+			return noMatch
+		case noMatch:
+			return match
+		}
+
+		return unknown
+	case model.ConstraintEquals:
+		return matchIf(e.equals(entity, typed.Doc, typed.Value))
+	case model.ConstraintIn:
+		for _, value := range typed.Values {
+			if e.equals(entity, typed.Doc, value) {
+				return match
+			}
+		}
+
+		return noMatch
+	case model.ConstraintPaths:
+		if entity.field == "" {
+			return matchIf(!deny)
+		}
+
+		for _, path := range typed {
+			if matchesPath(path, entity.field, entity.locale) {
+				return match
+			}
+		}
+
+		return noMatch
+	}
+
+	return unknown
+}
+
+func (e *Evaluator) equals(entity Entity, doc string, expected string) bool {
+	if expected == model.ConstraintCurrentUser {
+		expected = e.userID
+	}
+
+	value, ok := entity.lookup(doc)
+	if !ok {
+		return false
+	}
+
+	if values, ok := value.([]any); ok {
+		for _, item := range values {
+			if stringValue(item) == expected {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return stringValue(value) == expected
+}
+
+// matchesPath reports whether a path like fields.%.de-DE covers the field in the locale
+func matchesPath(path string, field string, locale string) bool {
+	segments := strings.Split(path, ".")
+	if len(segments) < 2 || segments[0] != "fields" {
+		return false
+	}
+
+	if segments[1] != model.ConstraintWildcard && segments[1] != field {
+		return false
+	}
+
+	return len(segments) == 2 || segments[2] == model.ConstraintWildcard || segments[2] == locale
+}
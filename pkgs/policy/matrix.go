@@ -0,0 +1,81 @@
+package policy
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+)
+
+// Matrix holds for every entity whether each of the actions is allowed
+type Matrix struct {
+	Actions []string
+	Rows    []MatrixRow
+}
+
+type MatrixRow struct {
+	Entity  Entity
+	Allowed map[string]bool
+}
+
+// Matrix evaluates the actions for every entity
+func (e *Evaluator) Matrix(actions []string, entities ...Entity) *Matrix {
+	matrix := &Matrix{Actions: actions}
+
+	for _, entity := range entities {
+		row := MatrixRow{Entity: entity, Allowed: make(map[string]bool, len(actions))}
+
+		for _, action := range actions {
+			row.Allowed[action] = e.Can(action, entity)
+		}
+
+		matrix.Rows = append(matrix.Rows, row)
+	}
+
+	return matrix
+}
+
+// FieldMatrix evaluates the actions for every field of the entity in every locale
+func (e *Evaluator) FieldMatrix(actions []string, entity Entity, fieldIds []string, locales []string) *Matrix {
+	entities := make([]Entity, 0, len(fieldIds)*len(locales))
+
+	for _, field := range fieldIds {
+		for _, locale := range locales {
+			entities = append(entities, entity.Field(field, locale))
+		}
+	}
+
+	return e.Matrix(actions, entities...)
+}
+
+// String renders the matrix as table, with a column per action
+func (m *Matrix) String() string {
+	var buffer bytes.Buffer
+
+	writer := tabwriter.NewWriter(&buffer, 0, 4, 2, ' ', 0)
+
+	_, _ = fmt.Fprint(writer, "entity")
+	for _, action := range m.Actions {
+		_, _ = fmt.Fprint(writer, "\t"+action)
+	}
+
+	_, _ = fmt.Fprintln(writer)
+
+	for _, row := range m.Rows {
+		_, _ = fmt.Fprint(writer, row.Entity.String())
+
+		for _, action := range m.Actions {
+			mark := "-"
+			if row.Allowed[action] {
+				mark = "x"
+			}
+
+			_, _ = fmt.Fprint(writer, "\t"+mark)
+		}
+
+		_, _ = fmt.Fprintln(writer)
+	}
+
+	_ = writer.Flush()
+
+	return buffer.String()
+}
@@ -0,0 +1,387 @@
+package replica
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labd/contentful-go/pkgs/common"
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+// ErrUnsupportedQuery is returned for query parameters which can not be evaluated locally
+var ErrUnsupportedQuery = errors.New("unsupported query")
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+type condition struct {
+	path     []string
+	operator string
+	value    string
+}
+
+type localQuery struct {
+	contentType string
+	locale      string
+	conditions  []condition
+	order       []string
+	limit       int
+	skip        int
+}
+
+// Query returns the entries matching the query. The equality, [in], [exists],
+// [lt], [lte], [gt] and [gte] operators are supported on sys and fields paths,
+// together with content type, locale, order, limit and skip. Other parameters
+// result in ErrUnsupportedQuery. Unlike the API, no limit returns all matches.
+func (s *Store) Query(query *common.Query) ([]*model.Entry, error) {
+	local, err := parseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if local.locale == "" {
+		local.locale = s.locale
+	}
+
+	s.mutex.RLock()
+
+	candidates := s.entries
+	if local.contentType != "" {
+		candidates = s.byContentType[local.contentType]
+	}
+
+	var matches []*record
+	for _, indexed := range candidates {
+		if s.matches(indexed, local) {
+			matches = append(matches, indexed)
+		}
+	}
+
+	s.mutex.RUnlock()
+
+	s.sort(matches, local)
+
+	if local.skip >= len(matches) {
+		return []*model.Entry{}, nil
+	}
+
+	matches = matches[local.skip:]
+
+	if local.limit > 0 && local.limit < len(matches) {
+		matches = matches[:local.limit]
+	}
+
+	entries := make([]*model.Entry, 0, len(matches))
+	for _, indexed := range matches {
+		entries = append(entries, indexed.entry)
+	}
+
+	return entries, nil
+}
+
+func parseQuery(query *common.Query) (*localQuery, error) {
+	local := &localQuery{}
+
+	values := url.Values{}
+	if query != nil {
+		values = query.Values()
+	}
+
+	for key, params := range values {
+		value := params[0]
+
+		switch key {
+		case "content_type":
+			local.contentType = value
+			continue
+		case "locale":
+			if value == "*" {
+				return nil, fmt.Errorf("%w: locale *", ErrUnsupportedQuery)
+			}
+
+			local.locale = value
+			continue
+		case "order":
+			local.order = strings.Split(value, ",")
+
+			for _, order := range local.order {
+				if !validPath(strings.TrimPrefix(order, "-")) {
+					return nil, fmt.Errorf("%w: order by %s", ErrUnsupportedQuery, order)
+				}
+			}
+
+			continue
+		case "limit":
+			local.limit, _ = strconv.Atoi(value)
+			continue
+		case "skip":
+			local.skip, _ = strconv.Atoi(value)
+			continue
+		case "include":
+			// links are resolved through the store
+			continue
+		}
+
+		path, operator := key, ""
+		if start := strings.Index(key, "["); start > 0 && strings.HasSuffix(key, "]") {
+			path, operator = key[:start], key[start+1:len(key)-1]
+		}
+
+		switch operator {
+		case "", "in", "exists", "lt", "lte", "gt", "gte":
+		default:
+			return nil, fmt.Errorf("%w: operator [%s] on %s", ErrUnsupportedQuery, operator, path)
+		}
+
+		if !validPath(path) {
+			return nil, fmt.Errorf("%w: parameter %s", ErrUnsupportedQuery, key)
+		}
+
+		local.conditions = append(local.conditions, condition{
+			path:     strings.Split(path, "."),
+			operator: operator,
+			value:    value,
+		})
+	}
+
+	// a deterministic default order, like the API which orders by sys.updatedAt
+	if len(local.order) == 0 {
+		local.order = []string{"-sys.updatedAt", "sys.id"}
+	}
+
+	return local, nil
+}
+
+// validPath reports whether the path points into the sys or fields of an entry
+func validPath(path string) bool {
+	segments := strings.Split(path, ".")
+	return len(segments) >= 2 && (segments[0] == "sys" || segments[0] == "fields")
+}
+
+func (s *Store) matches(indexed *record, query *localQuery) bool {
+	for _, condition := range query.conditions {
+		value := s.lookup(indexed, condition.path, query.locale)
+
+		if !matchesCondition(value, condition) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookup returns the value at the path of the entry, like sys.id or fields.author.sys.id
+func (s *Store) lookup(indexed *record, path []string, locale string) any {
+	var value any
+
+	if path[0] == "sys" {
+		value = indexed.sys
+	} else {
+		field, ok := indexed.entry.Fields[path[1]]
+		if !ok {
+			return nil
+		}
+
+		value = localize(field, locale, s.locale)
+		path = path[1:]
+	}
+
+	for _, segment := range path[1:] {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		value = nested[segment]
+	}
+
+	return value
+}
+
+// localize returns the value of a localized field for the locale, or for the fallback
+// locale when it has no value. Fields of single locale entries are returned as is.
+func localize(field any, locale, fallback string) any {
+	localized, ok := field.(map[string]any)
+	if !ok {
+		return field
+	}
+
+	if value, ok := localized[locale]; ok {
+		return value
+	}
+
+	if value, ok := localized[fallback]; ok {
+		return value
+	}
+
+	return nil
+}
+
+func matchesCondition(value any, condition condition) bool {
+	switch condition.operator {
+	case "exists":
+		return (value != nil) == (condition.value == "true")
+	case "in":
+		for _, expected := range strings.Split(condition.value, ",") {
+			if equals(value, expected) {
+				return true
+			}
+		}
+
+		return false
+	case "":
+		return equals(value, condition.value)
+	}
+
+	result, ok := compareTo(value, condition.value)
+	if !ok {
+		return false
+	}
+
+	switch condition.operator {
+	case "lt":
+		return result < 0
+	case "lte":
+		return result <= 0
+	case "gt":
+		return result > 0
+	case "gte":
+		return result >= 0
+	}
+
+	return false
+}
+
+// equals compares a value with a query parameter. Array values match when any item matches.
+func equals(value any, expected string) bool {
+	switch typed := value.(type) {
+	case []any:
+		for _, item := range typed {
+			if equals(item, expected) {
+				return true
+			}
+		}
+	case string:
+		return typed == expected
+	case float64:
+		number, err := strconv.ParseFloat(expected, 64)
+		return err == nil && number == typed
+	case bool:
+		return strconv.FormatBool(typed) == expected
+	}
+
+	return false
+}
+
+// compareTo compares a value with a query parameter as number, date or string
+func compareTo(value any, bound string) (int, bool) {
+	switch typed := value.(type) {
+	case float64:
+		number, err := strconv.ParseFloat(bound, 64)
+		if err != nil {
+			return 0, false
+		}
+
+		return compareValues(typed, number), true
+	case string:
+		if moment, ok := parseTime(typed); ok {
+			if boundMoment, ok := parseTime(bound); ok {
+				return moment.Compare(boundMoment), true
+			}
+		}
+
+		return strings.Compare(typed, bound), true
+	}
+
+	return 0, false
+}
+
+func (s *Store) sort(matches []*record, query *localQuery) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		for _, order := range query.order {
+			path, reverse := strings.TrimPrefix(order, "-"), strings.HasPrefix(order, "-")
+			segments := strings.Split(path, ".")
+
+			left := s.lookup(matches[i], segments, query.locale)
+			right := s.lookup(matches[j], segments, query.locale)
+
+			// missing values are ordered last, regardless of the direction
+			if left == nil || right == nil {
+				if (left == nil) != (right == nil) {
+					return right == nil
+				}
+
+				continue
+			}
+
+			result := compareAny(left, right)
+			if result == 0 {
+				continue
+			}
+
+			if reverse {
+				return result > 0
+			}
+
+			return result < 0
+		}
+
+		return false
+	})
+}
+
+func compareAny(left, right any) int {
+	switch typed := left.(type) {
+	case float64:
+		if other, ok := right.(float64); ok {
+			return compareValues(typed, other)
+		}
+	case string:
+		if other, ok := right.(string); ok {
+			result, _ := compareTo(typed, other)
+			return result
+		}
+	case bool:
+		if other, ok := right.(bool); ok && typed != other {
+			if typed {
+				return 1
+			}
+
+			return -1
+		}
+
+		return 0
+	}
+
+	return strings.Compare(fmt.Sprint(left), fmt.Sprint(right))
+}
+
+func compareValues(left, right float64) int {
+	switch {
+	case left < right:
+		return -1
+	case left > right:
+		return 1
+	}
+
+	return 0
+}
+
+func parseTime(value string) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		if moment, err := time.Parse(layout, value); err == nil {
+			return moment, true
+		}
+	}
+
+	return time.Time{}, false
+}
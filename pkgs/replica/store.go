@@ -0,0 +1,267 @@
+// Package replica keeps a local, queryable copy of the published content of an
+// environment, kept current through the Sync API of the Content Delivery API.
+package replica
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/pkgs/syncstore"
+	"github.com/labd/contentful-go/service/cda"
+)
+
+type Options struct {
+	// Locale is used for queries without a locale and as fallback when a field
+	// has no value for the requested locale. Defaults to en-US.
+	Locale string
+}
+
+// Store is a local replica of the entries and assets of an environment. It is
+// safe for concurrent use, so it can be queried while Poll runs in the
+// background. Returned entries and assets are shared and must not be modified.
+type Store struct {
+	mutex         sync.RWMutex
+	engine        cda.SyncEngine
+	locale        string
+	entries       map[string]*record
+	assets        map[string]*model.Asset
+	byContentType map[string]map[string]*record
+}
+
+// record is an indexed entry, with its sys as generic map for querying
+type record struct {
+	entry *model.Entry
+	sys   map[string]any
+}
+
+// New creates an empty store, which is filled on the first call of Sync or Poll.
+// The sync token is kept in memory, since a new store always needs an initial sync.
+func New(syncService cda.Sync, options *Options) *Store {
+	if options == nil {
+		options = &Options{}
+	}
+
+	locale := options.Locale
+	if locale == "" {
+		locale = "en-US"
+	}
+
+	return &Store{
+		engine:        syncService.Engine(syncstore.NewMemory(), cda.All, nil),
+		locale:        locale,
+		entries:       map[string]*record{},
+		assets:        map[string]*model.Asset{},
+		byContentType: map[string]map[string]*record{},
+	}
+}
+
+// Sync applies all changes since the previous sync
+func (s *Store) Sync(ctx context.Context) error {
+	return s.engine.Run(ctx, func(ctx context.Context, event cda.SyncEvent) error {
+		return s.apply(event)
+	})
+}
+
+// Poll syncs immediately and then on every interval until the context is done.
+// Sync errors are passed to onError, when given, and do not stop polling.
+func (s *Store) Poll(ctx context.Context, interval time.Duration, onError func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := s.Sync(ctx)
+		if err != nil && ctx.Err() == nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Store) apply(event cda.SyncEvent) error {
+	switch typed := event.(type) {
+	case cda.EntryCreated:
+		return s.putEntry(typed.Entry)
+	case cda.EntryUpdated:
+		return s.putEntry(typed.Entry)
+	case cda.EntryDeleted:
+		s.deleteEntry(typed.Sys.ID)
+	case cda.AssetCreated:
+		s.putAsset(typed.Asset)
+	case cda.AssetUpdated:
+		s.putAsset(typed.Asset)
+	case cda.AssetDeleted:
+		s.mutex.Lock()
+		delete(s.assets, typed.Sys.ID)
+		s.mutex.Unlock()
+	}
+
+	return nil
+}
+
+func (s *Store) putEntry(entry *model.Entry) error {
+	if entry.Sys == nil {
+		return nil
+	}
+
+	bytesArray, err := json.Marshal(entry.Sys)
+	if err != nil {
+		return err
+	}
+
+	indexed := &record{entry: entry}
+	err = json.Unmarshal(bytesArray, &indexed.sys)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.removeEntry(entry.Sys.ID)
+
+	s.entries[entry.Sys.ID] = indexed
+
+	contentType := contentTypeOf(entry)
+	if s.byContentType[contentType] == nil {
+		s.byContentType[contentType] = map[string]*record{}
+	}
+
+	s.byContentType[contentType][entry.Sys.ID] = indexed
+
+	return nil
+}
+
+func (s *Store) deleteEntry(id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.removeEntry(id)
+}
+
+// removeEntry removes an entry from all indexes, the caller must hold the write lock
+func (s *Store) removeEntry(id string) {
+	existing, ok := s.entries[id]
+	if !ok {
+		return
+	}
+
+	delete(s.entries, id)
+
+	contentType := contentTypeOf(existing.entry)
+	delete(s.byContentType[contentType], id)
+
+	if len(s.byContentType[contentType]) == 0 {
+		delete(s.byContentType, contentType)
+	}
+}
+
+func (s *Store) putAsset(asset *model.Asset) {
+	if asset.Sys == nil {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.assets[asset.Sys.ID] = asset
+}
+
+func contentTypeOf(entry *model.Entry) string {
+	if entry.Sys == nil || entry.Sys.ContentType == nil {
+		return ""
+	}
+
+	return entry.Sys.ContentType.Sys.ID
+}
+
+// Entry returns the entry with the given id
+func (s *Store) Entry(id string) (*model.Entry, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	indexed, ok := s.entries[id]
+	if !ok {
+		return nil, false
+	}
+
+	return indexed.entry, true
+}
+
+// Asset returns the asset with the given id
+func (s *Store) Asset(id string) (*model.Asset, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	asset, ok := s.assets[id]
+	return asset, ok
+}
+
+// Resolve returns the entry or asset the link points to, or nil when it is not in the store
+func (s *Store) Resolve(link model.FieldLink) any {
+	switch link.LinkType {
+	case model.LinkTypeEntry:
+		if entry, ok := s.Entry(link.ID); ok {
+			return entry
+		}
+	case model.LinkTypeAsset:
+		if asset, ok := s.Asset(link.ID); ok {
+			return asset
+		}
+	}
+
+	return nil
+}
+
+// Graph returns the entry with everything it links to, directly or indirectly.
+// Links to entities which are not in the store are reported by Unresolved.
+func (s *Store) Graph(entryId string) (*model.ReferenceGraph, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	root, ok := s.entries[entryId]
+	if !ok {
+		return nil, false
+	}
+
+	graph := &model.ReferenceGraph{
+		Root:    root.entry,
+		Entries: map[string]*model.Entry{entryId: root.entry},
+		Assets:  map[string]*model.Asset{},
+	}
+
+	queue := []*model.Entry{root.entry}
+
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+
+		for _, link := range parent.Links() {
+			if link.LinkType == model.LinkTypeAsset {
+				if asset, ok := s.assets[link.ID]; ok {
+					graph.Assets[link.ID] = asset
+				}
+
+				continue
+			}
+
+			if _, visited := graph.Entries[link.ID]; visited {
+				continue
+			}
+
+			if child, ok := s.entries[link.ID]; ok {
+				graph.Entries[link.ID] = child.entry
+				queue = append(queue, child.entry)
+			}
+		}
+	}
+
+	return graph, true
+}
@@ -0,0 +1,112 @@
+package syncstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/labd/contentful-go/service/cda"
+)
+
+var _ cda.SyncStore = &File{}
+
+// File keeps sync tokens in a JSON file. The file is replaced atomically on every
+// save, so an interrupted process never leaves a partially written file.
+type File struct {
+	mutex sync.Mutex
+	path  string
+}
+
+func NewFile(path string) *File {
+	return &File{path: path}
+}
+
+func (f *File) Load(_ context.Context, key string) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	tokens, err := f.read()
+	if err != nil {
+		return "", err
+	}
+
+	return tokens[key], nil
+}
+
+func (f *File) Save(_ context.Context, key string, token string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	tokens, err := f.read()
+	if err != nil {
+		return err
+	}
+
+	tokens[key] = token
+	return f.write(tokens)
+}
+
+func (f *File) Delete(_ context.Context, key string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	tokens, err := f.read()
+	if err != nil {
+		return err
+	}
+
+	delete(tokens, key)
+	return f.write(tokens)
+}
+
+func (f *File) read() (map[string]string, error) {
+	tokens := map[string]string{}
+
+	content, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return tokens, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(content, &tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func (f *File) write(tokens map[string]string) error {
+	content, err := json.MarshalIndent(tokens, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(temp.Name())
+
+	_, err = temp.Write(content)
+	if err == nil {
+		err = temp.Sync()
+	}
+
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(temp.Name(), f.path)
+}
@@ -0,0 +1,51 @@
+// Package syncstore contains implementations of cda.SyncStore
+package syncstore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/labd/contentful-go/service/cda"
+)
+
+var _ cda.SyncStore = &Memory{}
+
+// Memory keeps sync tokens in memory, which is useful for tests and short-lived processes.
+// The zero value is an empty store ready to use.
+type Memory struct {
+	mutex  sync.RWMutex
+	tokens map[string]string
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		tokens: map[string]string{},
+	}
+}
+
+func (m *Memory) Load(_ context.Context, key string) (string, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.tokens[key], nil
+}
+
+func (m *Memory) Save(_ context.Context, key string, token string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.tokens == nil {
+		m.tokens = map[string]string{}
+	}
+
+	m.tokens[key] = token
+	return nil
+}
+
+func (m *Memory) Delete(_ context.Context, key string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.tokens, key)
+	return nil
+}
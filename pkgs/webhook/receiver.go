@@ -0,0 +1,200 @@
+// Package webhook receives Contentful webhooks: it verifies signed requests and
+// dispatches their payloads to handlers registered per topic. For local testing
+// it signs synthetic requests and renders webhook transformations.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+// DefaultMaxBodySize is the largest payload read by a receiver
+const DefaultMaxBodySize = 1 << 20
+
+// Event is a received webhook. Depending on the topic one of Entry, Asset,
+// ContentType or Deleted is set; the payload of other topics is only available raw.
+type Event struct {
+	Topic       Topic
+	Header      http.Header
+	Payload     []byte
+	Entry       *model.Entry
+	Asset       *model.Asset
+	ContentType *model.ContentType
+	// Deleted is the sys of an unpublished or deleted entity
+	Deleted *model.DeletedSys
+}
+
+type Handler func(ctx context.Context, event *Event) error
+
+type ReceiverOptions struct {
+	// Secret is the webhook signing secret of the space
+	Secret string
+	// SkipVerification accepts unsigned requests, it is ignored when a secret is set
+	SkipVerification bool
+	// SignatureTTL is how long a signed request is accepted, defaults to DefaultSignatureTTL
+	SignatureTTL time.Duration
+	// MaxBodySize defaults to DefaultMaxBodySize
+	MaxBodySize int64
+}
+
+type route struct {
+	pattern string
+	handler Handler
+}
+
+// Receiver is an http.Handler for Contentful webhooks. Every handler with a
+// pattern matching the topic is called in order of registration. The request
+// is answered with 500 when a handler fails, so Contentful retries it.
+type Receiver struct {
+	mutex   sync.RWMutex
+	options ReceiverOptions
+	routes  []route
+	now     func() time.Time
+}
+
+var _ http.Handler = &Receiver{}
+
+func NewReceiver(options ReceiverOptions) *Receiver {
+	if options.SignatureTTL == 0 {
+		options.SignatureTTL = DefaultSignatureTTL
+	}
+
+	if options.MaxBodySize == 0 {
+		options.MaxBodySize = DefaultMaxBodySize
+	}
+
+	return &Receiver{
+		options: options,
+		now:     time.Now,
+	}
+}
+
+// Handle registers a handler for the topic pattern, see Topic.Matches
+func (r *Receiver) Handle(pattern string, handler Handler) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.routes = append(r.routes, route{pattern: pattern, handler: handler})
+}
+
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, r.options.MaxBodySize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	if r.options.Secret != "" {
+		err = verifyRequest(req, body, r.options.Secret, r.options.SignatureTTL, r.now())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	} else if !r.options.SkipVerification {
+		http.Error(w, "webhook signing secret is not configured", http.StatusUnauthorized)
+		return
+	}
+
+	event, err := newEvent(req.Header, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	r.mutex.RLock()
+	routes := append([]route{}, r.routes...)
+	r.mutex.RUnlock()
+
+	for _, route := range routes {
+		if !event.Topic.Matches(route.pattern) {
+			continue
+		}
+
+		err = route.handler(req.Context(), event)
+		if err != nil {
+			http.Error(w, "webhook handler failed", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func newEvent(header http.Header, body []byte) (*Event, error) {
+	topic, err := ParseTopic(header.Get(HeaderTopic))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &Event{
+		Topic:   topic,
+		Header:  header,
+		Payload: body,
+	}
+
+	var payload struct {
+		Sys struct {
+			Type string `json:"type"`
+		} `json:"sys"`
+	}
+
+	if len(body) == 0 {
+		return event, nil
+	}
+
+	if err = json.Unmarshal(body, &payload); err != nil {
+		return nil, errors.New("invalid webhook payload")
+	}
+
+	var target any
+
+	switch payload.Sys.Type {
+	case "Entry":
+		event.Entry = &model.Entry{}
+		target = event.Entry
+	case "Asset":
+		event.Asset = &model.Asset{}
+		target = event.Asset
+	case "ContentType":
+		event.ContentType = &model.ContentType{}
+		target = event.ContentType
+	case "DeletedEntry", "DeletedAsset", "DeletedContentType":
+		deleted := struct {
+			Sys *model.DeletedSys `json:"sys"`
+		}{}
+
+		if err = json.Unmarshal(body, &deleted); err != nil {
+			return nil, errors.New("invalid webhook payload")
+		}
+
+		event.Deleted = deleted.Sys
+		return event, nil
+	default:
+		return event, nil
+	}
+
+	if err = json.Unmarshal(body, target); err != nil {
+		return nil, errors.New("invalid webhook payload")
+	}
+
+	return event, nil
+}
@@ -0,0 +1,199 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// noinspection GoUnusedConst
+const (
+	HeaderSignature     = "X-Contentful-Signature"
+	HeaderSignedHeaders = "X-Contentful-Signed-Headers"
+	HeaderTimestamp     = "X-Contentful-Timestamp"
+	HeaderTopic         = "X-Contentful-Topic"
+
+	// DefaultSignatureTTL is how long a signed request is accepted after it was sent
+	DefaultSignatureTTL = 30 * time.Second
+
+	// SecretLength is the length of a webhook signing secret
+	SecretLength = 64
+)
+
+const secretAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+var (
+	ErrMissingSignature = errors.New("webhook request is not signed")
+	ErrInvalidSignature = errors.New("webhook request signature is invalid")
+	ErrExpiredSignature = errors.New("webhook request signature is expired")
+	ErrInvalidSecret    = errors.New("webhook signing secret must be 64 alphanumeric characters")
+)
+
+// GenerateSecret returns a random signing secret, as accepted by Contentful
+func GenerateSecret() (string, error) {
+	secret := make([]byte, SecretLength)
+	max := big.NewInt(int64(len(secretAlphabet)))
+
+	for i := range secret {
+		index, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+
+		secret[i] = secretAlphabet[index.Int64()]
+	}
+
+	return string(secret), nil
+}
+
+// ValidateSecret checks whether the secret is accepted as signing secret by Contentful
+func ValidateSecret(secret string) error {
+	if len(secret) != SecretLength {
+		return ErrInvalidSecret
+	}
+
+	for _, char := range secret {
+		if !strings.ContainsRune(secretAlphabet, char) {
+			return ErrInvalidSecret
+		}
+	}
+
+	return nil
+}
+
+// Sign signs the request like Contentful signs webhook requests, so receivers can
+// be tested with synthetic requests. The timestamp header is always signed, together
+// with the given headers. The body is read and replaced, so it can still be sent.
+func Sign(req *http.Request, secret string, timestamp time.Time, headers ...string) error {
+	body, err := readBody(req)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.UnixMilli(), 10))
+
+	signedHeaders := []string{strings.ToLower(HeaderTimestamp)}
+	for _, name := range headers {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(signedHeaders, name) {
+			signedHeaders = append(signedHeaders, name)
+		}
+	}
+
+	// like Contentful, sign the headers in alphabetical order
+	slices.Sort(signedHeaders)
+
+	req.Header.Set(HeaderSignedHeaders, strings.Join(signedHeaders, ","))
+
+	canonical := canonicalRequest(req.Method, req.URL.RequestURI(), req.Header, signedHeaders, body)
+	req.Header.Set(HeaderSignature, signature(secret, canonical))
+
+	return nil
+}
+
+// Verify checks the signature of the request and rejects signatures older than
+// the ttl, which defaults to DefaultSignatureTTL, or more than the ttl ahead of now.
+// The body is read and replaced, so it can still be read by the caller.
+func Verify(req *http.Request, secret string, ttl time.Duration) error {
+	if ttl == 0 {
+		ttl = DefaultSignatureTTL
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		return err
+	}
+
+	return verifyRequest(req, body, secret, ttl, time.Now())
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return []byte{}, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// canonicalRequest returns the string which Contentful signs: the method, the
+// path with query, the signed headers and the body separated by newlines
+func canonicalRequest(method, path string, header http.Header, signedHeaders []string, body []byte) string {
+	headers := make([]string, 0, len(signedHeaders))
+	for _, name := range signedHeaders {
+		headers = append(headers, name+":"+strings.TrimSpace(header.Get(name)))
+	}
+
+	return strings.Join([]string{method, path, strings.Join(headers, ";"), string(body)}, "\n")
+}
+
+func signature(secret string, canonical string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(canonical))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifyRequest checks the signature of the request with the body, which has
+// already been read, and rejects signatures older than the ttl or more than the
+// ttl ahead of now
+func verifyRequest(req *http.Request, body []byte, secret string, ttl time.Duration, now time.Time) error {
+	received := req.Header.Get(HeaderSignature)
+	signedHeadersValue := req.Header.Get(HeaderSignedHeaders)
+
+	if received == "" || signedHeadersValue == "" {
+		return ErrMissingSignature
+	}
+
+	var signedHeaders []string
+	timestampSigned := false
+
+	for _, name := range strings.Split(signedHeadersValue, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		signedHeaders = append(signedHeaders, name)
+		timestampSigned = timestampSigned || name == strings.ToLower(HeaderTimestamp)
+	}
+
+	if !timestampSigned {
+		return fmt.Errorf("%w: timestamp is not signed", ErrInvalidSignature)
+	}
+
+	timestamp, err := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid timestamp", ErrInvalidSignature)
+	}
+
+	// timestamps in the future are rejected too, otherwise a request signed ahead
+	// of time can be replayed until then
+	age := now.Sub(time.UnixMilli(timestamp))
+	if age > ttl || age < -ttl {
+		return ErrExpiredSignature
+	}
+
+	canonical := canonicalRequest(req.Method, req.URL.RequestURI(), req.Header, signedHeaders, body)
+	expected := signature(secret, canonical)
+
+	if !hmac.Equal([]byte(expected), bytes.ToLower([]byte(received))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+// ErrTemplate is returned when a body template can not be rendered
+var ErrTemplate = errors.New("webhook template error")
+
+// placeholder matches template placeholders like { /payload/sys/id }
+var placeholder = regexp.MustCompile(`\{\s*(/[^{}]*?)\s*\}`)
+
+// TemplateContext is what placeholders of a body template are evaluated against:
+// /topic, /user/sys/id and /payload
+type TemplateContext struct {
+	// Topic is the full topic, like ContentManagement.Entry.publish
+	Topic   string
+	UserID  string
+	Payload json.RawMessage
+}
+
+// RenderedRequest is the request a webhook sends after its transformation is applied
+type RenderedRequest struct {
+	Method string
+	Header http.Header
+	Body   []byte
+}
+
+// Render applies the transformation to the context, like Contentful does before
+// sending a webhook. Without transformation the payload is sent unmodified.
+func Render(transformation *model.WebhookTransformation, context TemplateContext) (*RenderedRequest, error) {
+	if transformation == nil {
+		transformation = &model.WebhookTransformation{}
+	}
+
+	err := transformation.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTemplate, err)
+	}
+
+	request := &RenderedRequest{
+		Method: transformation.Method,
+		Header: make(http.Header),
+		Body:   context.Payload,
+	}
+
+	if request.Method == "" {
+		request.Method = http.MethodPost
+	}
+
+	contentType := transformation.ContentType
+	if contentType == "" {
+		contentType = model.WebhookContentTypeManagement
+	}
+
+	request.Header.Set("Content-Type", contentType)
+
+	if len(transformation.Body) > 0 {
+		request.Body, err = RenderTemplate(transformation.Body, context)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if strings.HasPrefix(contentType, model.WebhookContentTypeFormURLEncoded) {
+		request.Body, err = formEncode(request.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if transformation.IncludeContentLength != nil && *transformation.IncludeContentLength {
+		request.Header.Set("Content-Length", strconv.Itoa(len(request.Body)))
+	}
+
+	return request, nil
+}
+
+// RenderTemplate evaluates the placeholders in the string values of a JSON body
+// template. A value which is a single placeholder is replaced by the value the
+// pointer resolves to, which keeps its JSON type. Placeholders within text are
+// replaced by their value as text.
+func RenderTemplate(template json.RawMessage, context TemplateContext) (json.RawMessage, error) {
+	var payload any
+	if len(context.Payload) > 0 {
+		err := decodeJSON(context.Payload, &payload)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid payload: %w", ErrTemplate, err)
+		}
+	}
+
+	root := map[string]any{
+		"topic":   context.Topic,
+		"user":    map[string]any{"sys": map[string]any{"id": context.UserID}},
+		"payload": payload,
+	}
+
+	var body any
+	err := decodeJSON(template, &body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid template: %w", ErrTemplate, err)
+	}
+
+	rendered, err := renderValue(body, root)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(rendered)
+}
+
+func renderValue(value any, root any) (any, error) {
+	switch typed := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(typed))
+
+		for key, item := range typed {
+			rendered, err := renderValue(item, root)
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = rendered
+		}
+
+		return result, nil
+	case []any:
+		result := make([]any, len(typed))
+
+		for i, item := range typed {
+			rendered, err := renderValue(item, root)
+			if err != nil {
+				return nil, err
+			}
+
+			result[i] = rendered
+		}
+
+		return result, nil
+	case string:
+		return renderString(typed, root)
+	}
+
+	return value, nil
+}
+
+func renderString(value string, root any) (any, error) {
+	if match := placeholder.FindStringSubmatchIndex(value); match != nil && match[0] == 0 && match[1] == len(value) {
+		return resolvePointer(root, value[match[2]:match[3]])
+	}
+
+	var err error
+
+	result := placeholder.ReplaceAllStringFunc(value, func(expression string) string {
+		if err != nil {
+			return ""
+		}
+
+		pointer := placeholder.FindStringSubmatch(expression)[1]
+
+		var resolved any
+		resolved, err = resolvePointer(root, pointer)
+
+		return text(resolved)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// resolvePointer returns the value at the JSON pointer, as defined by RFC 6901
+func resolvePointer(root any, pointer string) (any, error) {
+	value := root
+
+	for _, token := range strings.Split(pointer, "/")[1:] {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch typed := value.(type) {
+		case map[string]any:
+			item, ok := typed[token]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s can not be resolved", ErrTemplate, pointer)
+			}
+
+			value = item
+		case []any:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(typed) {
+				return nil, fmt.Errorf("%w: %s can not be resolved", ErrTemplate, pointer)
+			}
+
+			value = typed[index]
+		default:
+			return nil, fmt.Errorf("%w: %s can not be resolved", ErrTemplate, pointer)
+		}
+	}
+
+	return value, nil
+}
+
+// text returns the value as it is interpolated into a string
+func text(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case map[string]any, []any:
+		data, _ := json.Marshal(typed)
+		return string(data)
+	}
+
+	return fmt.Sprint(value)
+}
+
+// formEncode encodes the properties of a JSON object as form values, nested
+// objects and arrays are encoded as JSON
+func formEncode(body []byte) ([]byte, error) {
+	var object map[string]any
+
+	err := decodeJSON(body, &object)
+	if err != nil {
+		return nil, fmt.Errorf("%w: form encoded body must be a JSON object", ErrTemplate)
+	}
+
+	values := url.Values{}
+	for key, value := range object {
+		values.Set(key, text(value))
+	}
+
+	return []byte(values.Encode()), nil
+}
+
+// decodeJSON decodes numbers as json.Number, so they are rendered as they were sent
+func decodeJSON(data []byte, value any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(value)
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Topic is the parsed X-Contentful-Topic header, like ContentManagement.Entry.publish
+type Topic struct {
+	Namespace string
+	Type      string
+	Action    string
+}
+
+// ParseTopic parses a topic of the form Namespace.Type.action
+func ParseTopic(value string) (Topic, error) {
+	parts := strings.Split(value, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return Topic{}, fmt.Errorf("invalid webhook topic %q", value)
+	}
+
+	return Topic{
+		Namespace: parts[0],
+		Type:      parts[1],
+		Action:    parts[2],
+	}, nil
+}
+
+func (t Topic) String() string {
+	return t.Namespace + "." + t.Type + "." + t.Action
+}
+
+// Matches reports whether the topic matches the pattern. A pattern has the form
+// of a topic where each part can be a * wildcard, like ContentManagement.Entry.*
+// or ContentManagement.*.publish. A single * matches every topic.
+func (t Topic) Matches(pattern string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	parts := strings.Split(pattern, ".")
+	if len(parts) != 3 {
+		return false
+	}
+
+	for i, value := range []string{t.Namespace, t.Type, t.Action} {
+		if parts[i] != "*" && parts[i] != value {
+			return false
+		}
+	}
+
+	return true
+}
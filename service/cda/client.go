@@ -17,4 +17,5 @@ type SpaceIdClient interface {
 type EnvironmentClient interface {
 	common.EnvironmentClient
 	Sync() Sync
+	Entries() Entries
 }
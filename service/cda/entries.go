@@ -0,0 +1,23 @@
+package cda
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+	"github.com/labd/contentful-go/service/cma"
+)
+
+type Entries interface {
+	Get(ctx context.Context, entryId string) (*model.Entry, error)
+
+	List(ctx context.Context) cma.NextableCollection[*model.Entry, any]
+
+	// References returns the entry and the entries and assets it links to, up to depth levels deep
+	References(ctx context.Context, entryId string, depth uint16) (*model.ReferenceGraph, error)
+
+	// LinksToEntry returns all entries linking to the given entry
+	LinksToEntry(ctx context.Context, entryId string) ([]*model.Entry, error)
+
+	// LinksToAsset returns all entries linking to the given asset
+	LinksToAsset(ctx context.Context, assetId string) ([]*model.Entry, error)
+}
@@ -24,4 +24,6 @@ func (s SyncType) String() string {
 type Sync interface {
 	Init(ctx context.Context, syncType SyncType, contentType *string) cma.SyncCollection
 	GetFromSyncUrl(ctx context.Context, syncUrl string) cma.SyncCollection
+	// Engine returns a sync engine which keeps its sync token in the given store
+	Engine(store SyncStore, syncType SyncType, options *SyncEngineOptions) SyncEngine
 }
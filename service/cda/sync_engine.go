@@ -0,0 +1,78 @@
+package cda
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+// SyncStore persists sync tokens, so a sync can be resumed after a restart
+type SyncStore interface {
+	// Load returns the token stored for the key, or an empty string when there is none
+	Load(ctx context.Context, key string) (string, error)
+	Save(ctx context.Context, key string, token string) error
+	Delete(ctx context.Context, key string) error
+}
+
+type SyncEngineOptions struct {
+	// Key identifies the sync in the store. It defaults to the sync type and
+	// content type, so it should be set when a store is shared between spaces
+	// or environments.
+	Key string
+	// ContentType limits an Entry sync to a single content type
+	ContentType *string
+}
+
+type SyncEngine interface {
+	// Run syncs from the stored token, or starts an initial sync when there is none.
+	// Pages are fetched one at a time and every item is passed to the handler. The
+	// token is stored after all items of a page are handled, so a failed run is
+	// resumed from the first page which was not completed. Run returns once all
+	// changes are synced.
+	Run(ctx context.Context, handler SyncHandler) error
+	// Reset removes the stored token, so the next run starts an initial sync
+	Reset(ctx context.Context) error
+}
+
+type SyncHandler func(ctx context.Context, event SyncEvent) error
+
+// SyncEvent is one of EntryCreated, EntryUpdated, EntryDeleted, AssetCreated,
+// AssetUpdated and AssetDeleted. Entries and assets are reported as created when
+// their revision is 1 or they are part of an initial sync, and as updated otherwise.
+// Pages of an initial sync resumed from a stored token are handled as delta.
+type SyncEvent interface {
+	syncEvent()
+}
+
+type EntryCreated struct {
+	Entry *model.Entry
+}
+
+type EntryUpdated struct {
+	Entry *model.Entry
+}
+
+// EntryDeleted is emitted for DeletedEntry items, entries which are unpublished or deleted
+type EntryDeleted struct {
+	Sys *model.DeletedSys
+}
+
+type AssetCreated struct {
+	Asset *model.Asset
+}
+
+type AssetUpdated struct {
+	Asset *model.Asset
+}
+
+// AssetDeleted is emitted for DeletedAsset items, assets which are unpublished or deleted
+type AssetDeleted struct {
+	Sys *model.DeletedSys
+}
+
+func (EntryCreated) syncEvent() {}
+func (EntryUpdated) syncEvent() {}
+func (EntryDeleted) syncEvent() {}
+func (AssetCreated) syncEvent() {}
+func (AssetUpdated) syncEvent() {}
+func (AssetDeleted) syncEvent() {}
@@ -0,0 +1,35 @@
+package cma
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+// AccessTokensFilter narrows down the listed personal access tokens
+type AccessTokensFilter struct {
+	// Revoked lists only revoked tokens when true and only active tokens when false
+	Revoked *bool
+	// ExpiresBefore lists only tokens which expire before the time
+	ExpiresBefore *time.Time
+}
+
+// AccessTokens manages the personal access tokens of the current user
+type AccessTokens interface {
+	Get(ctx context.Context, tokenId string) (*model.PersonalAccessToken, error)
+
+	List(ctx context.Context, filter *AccessTokensFilter) NextableCollection[*model.PersonalAccessToken, any]
+
+	// Create creates the token, the token value is only returned by this call
+	Create(ctx context.Context, token *model.PersonalAccessToken) error
+
+	Revoke(ctx context.Context, token *model.PersonalAccessToken) error
+
+	// CheckExpiry returns the active tokens matching the token values which expire
+	// within the duration, and logs a warning for each of them when a logger is given.
+	// It is meant to be called at startup with the tokens the application uses. Tokens
+	// are matched best-effort, see model.PersonalAccessToken.Matches.
+	CheckExpiry(ctx context.Context, logger *slog.Logger, within time.Duration, tokens ...string) ([]*model.PersonalAccessToken, error)
+}
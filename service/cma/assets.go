@@ -2,10 +2,37 @@ package cma
 
 import (
 	"context"
+	"errors"
+	"io"
+	"time"
 
 	"github.com/labd/contentful-go/pkgs/model"
 )
 
+// ErrProcessingTimeout is returned when an asset file is not processed within the processing timeout
+var ErrProcessingTimeout = errors.New("asset was not processed in time")
+
+// AssetMetadata describes the asset created from an upload
+type AssetMetadata struct {
+	Title       map[string]string
+	Description map[string]string
+	FileName    string
+	ContentType string
+	// Locales the file is attached to, defaults to the locales of the title
+	Locales []string
+}
+
+type CreateAssetOptions struct {
+	Upload *UploadOptions
+	// ProcessingTimeout defaults to 2 minutes
+	ProcessingTimeout time.Duration
+	// PollInterval is the initial wait between polls and doubles until MaxPollInterval, defaults to 500 milliseconds
+	PollInterval time.Duration
+	// MaxPollInterval defaults to 10 seconds
+	MaxPollInterval time.Duration
+	Publish         bool
+}
+
 type Assets interface {
 	Get(ctx context.Context, assetId string) (*model.Asset, error)
 
@@ -15,6 +42,10 @@ type Assets interface {
 
 	Process(ctx context.Context, asset *model.Asset) error
 
+	// CreateFromReader uploads the reader, creates an asset for it, processes the file for
+	// all locales, waits for the processing to finish and optionally publishes the asset
+	CreateFromReader(ctx context.Context, reader io.Reader, metadata *AssetMetadata, options *CreateAssetOptions) (*model.Asset, error)
+
 	Delete(ctx context.Context, asset *model.Asset) error
 
 	Publish(ctx context.Context, asset *model.Asset) error
@@ -26,4 +57,7 @@ type Assets interface {
 	Unarchive(ctx context.Context, asset *model.Asset) error
 
 	ListPublished(ctx context.Context) NextableCollection[*model.Asset, any]
+
+	// Cascade unpublishes the asset when needed, handles entries linking to it and archives or deletes it
+	Cascade(ctx context.Context, asset *model.Asset, options CascadeOptions) (*CascadeReport, error)
 }
@@ -0,0 +1,69 @@
+package cma
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+// ErrEntityReferenced is returned by a cascade when the entity is still referenced
+// and the references policy is ReferencesAbort
+var ErrEntityReferenced = errors.New("entity is still referenced by other entries")
+
+type CascadeAction int
+
+const (
+	CascadeArchive CascadeAction = iota
+	CascadeDelete
+)
+
+func (a CascadeAction) String() string {
+	switch a {
+	case CascadeArchive:
+		return "archive"
+	case CascadeDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("CascadeAction(%d)", int(a))
+	}
+}
+
+// ReferencesPolicy defines what a cascade does with entries linking to the entity
+type ReferencesPolicy int
+
+const (
+	// ReferencesAbort stops the cascade before changing anything when the entity is referenced
+	ReferencesAbort ReferencesPolicy = iota
+	// ReferencesReport continues the cascade and only reports the referencing entries
+	ReferencesReport
+	// ReferencesRemove removes the links from the referencing entries and republishes
+	// the ones that were published without pending changes
+	ReferencesRemove
+)
+
+type CascadeOptions struct {
+	Action     CascadeAction
+	References ReferencesPolicy
+	// DryRun reports everything the cascade would touch without changing anything
+	DryRun bool
+}
+
+// CascadeReference is an entry linking to the entity of a cascade
+type CascadeReference struct {
+	Entry       *model.Entry
+	Links       []model.FieldLink
+	Removed     bool
+	Republished bool
+}
+
+// CascadeReport holds everything a cascade touched, or would touch on a dry run
+type CascadeReport struct {
+	DryRun      bool
+	Target      model.Link
+	Action      CascadeAction
+	Unpublished bool
+	Archived    bool
+	Deleted     bool
+	References  []*CascadeReference
+}
@@ -8,6 +8,9 @@ type SpaceIdClientBuilder interface {
 	common.RestClient
 	WithSpaceId(spaceId string) SpaceIdClient
 	WithOrganizationId(organizationId string) OrganizationIdClient
+	AccessTokens() AccessTokens
+	Spaces() Spaces
+	Organizations() Organizations
 }
 
 type SpaceIdClient interface {
@@ -17,6 +20,13 @@ type SpaceIdClient interface {
 	PreviewApiKeys() PreviewApiKeys
 	EnvironmentAliases() EnvironmentAliases
 	Environments() Environments
+	Uploads() Uploads
+	WebhookSigningSecret() WebhookSigningSecret
+	Webhooks() Webhooks
+	WebhookCalls() WebhookCalls
+	Roles() Roles
+	SpaceMemberships() SpaceMemberships
+	TeamSpaceMemberships() TeamSpaceMemberships
 }
 
 type EnvironmentClient interface {
@@ -26,9 +36,19 @@ type EnvironmentClient interface {
 	Assets() Assets
 	ContentTypes() ContentTypes
 	Locales() Locales
+	ScheduledActions() ScheduledActions
+	EditorInterfaces() EditorInterfaces
+	Extensions() Extensions
+	EntrySnapshots() EntrySnapshots
+	ContentTypeSnapshots() ContentTypeSnapshots
 }
 
 type OrganizationIdClient interface {
 	common.RestClient
 	AppDefinitions() AppDefinitions
+	OrganizationMemberships() OrganizationMemberships
+	Invitations() Invitations
+	Teams() Teams
+	TeamMemberships() TeamMemberships
+	Users() Users
 }
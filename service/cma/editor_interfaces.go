@@ -0,0 +1,18 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type EditorInterfaces interface {
+	// Get returns the editor interface of the content type
+	Get(ctx context.Context, contentTypeId string) (*model.EditorInterface, error)
+
+	// List returns the editor interfaces of all content types of the environment
+	List(ctx context.Context) NextableCollection[*model.EditorInterface, any]
+
+	// Update replaces the editor interface of its content type
+	Update(ctx context.Context, editorInterface *model.EditorInterface) error
+}
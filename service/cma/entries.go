@@ -24,4 +24,19 @@ type Entries interface {
 	Unarchive(ctx context.Context, entry *model.Entry) error
 
 	ListPublished(ctx context.Context) NextableCollection[*model.Entry, any]
+
+	// References returns the entry and the entries and assets it links to, up to depth levels deep
+	References(ctx context.Context, entryId string, depth uint16) (*model.ReferenceGraph, error)
+
+	// LinksToEntry returns all entries linking to the given entry
+	LinksToEntry(ctx context.Context, entryId string) ([]*model.Entry, error)
+
+	// LinksToAsset returns all entries linking to the given asset
+	LinksToAsset(ctx context.Context, assetId string) ([]*model.Entry, error)
+
+	// Cascade unpublishes the entry when needed, handles entries linking to it and archives or deletes it
+	Cascade(ctx context.Context, entry *model.Entry, options CascadeOptions) (*CascadeReport, error)
+
+	// Restore merges the fields of the snapshot onto the current version of the entry and publishes it when requested
+	Restore(ctx context.Context, entryId string, snapshotId string, options RestoreOptions) (*RestoreReport, error)
 }
@@ -0,0 +1,18 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type Extensions interface {
+	Get(ctx context.Context, extensionId string) (*model.Extension, error)
+
+	List(ctx context.Context) NextableCollection[*model.Extension, any]
+
+	// Upsert creates or updates the extension, after checking its installation parameters
+	Upsert(ctx context.Context, extension *model.Extension) error
+
+	Delete(ctx context.Context, extension *model.Extension) error
+}
@@ -0,0 +1,26 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type OrganizationMemberships interface {
+	Get(ctx context.Context, membershipId string) (*model.OrganizationMembership, error)
+
+	List(ctx context.Context) NextableCollection[*model.OrganizationMembership, any]
+
+	// Update changes the organization role of the member
+	Update(ctx context.Context, membership *model.OrganizationMembership) error
+
+	// Delete removes the member from the organization and all its spaces
+	Delete(ctx context.Context, membership *model.OrganizationMembership) error
+}
+
+type Invitations interface {
+	Get(ctx context.Context, invitationId string) (*model.Invitation, error)
+
+	// Create invites the user to the organization, which creates a pending organization membership
+	Create(ctx context.Context, invitation *model.Invitation) error
+}
@@ -0,0 +1,27 @@
+package cma
+
+import (
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+// RestoreOptions selects what is restored from a snapshot. Fields and Locales limit
+// the restore to the given field and locale ids, everything is restored when empty.
+type RestoreOptions struct {
+	Fields  []string
+	Locales []string
+	// Publish publishes the entry after the snapshot is restored
+	Publish bool
+	// DryRun reports the changes a restore would make without changing anything
+	DryRun bool
+}
+
+// RestoreReport holds the entry after the restore and the changes made to it, or
+// that would be made on a dry run
+type RestoreReport struct {
+	DryRun    bool
+	Entry     *model.Entry
+	Snapshot  *model.EntrySnapshot
+	Changes   []*model.FieldChange
+	Updated   bool
+	Published bool
+}
@@ -0,0 +1,17 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type Roles interface {
+	Get(ctx context.Context, roleId string) (*model.Role, error)
+
+	List(ctx context.Context) NextableCollection[*model.Role, any]
+
+	Upsert(ctx context.Context, role *model.Role) error
+
+	Delete(ctx context.Context, role *model.Role) error
+}
@@ -0,0 +1,27 @@
+package cma
+
+import (
+	"context"
+	"time"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+// ScheduledActionsFilter narrows down the listed scheduled actions
+type ScheduledActionsFilter struct {
+	EntityIds []string
+	Status    []model.ScheduledActionStatus
+}
+
+type ScheduledActions interface {
+	Get(ctx context.Context, scheduledActionId string) (*model.ScheduledAction, error)
+
+	List(ctx context.Context, filter *ScheduledActionsFilter) NextableCollection[*model.ScheduledAction, any]
+
+	Upsert(ctx context.Context, scheduledAction *model.ScheduledAction) error
+
+	Cancel(ctx context.Context, scheduledAction *model.ScheduledAction) error
+
+	// Conflicts returns the pending actions on the same entity that are executed within the given window
+	Conflicts(ctx context.Context, scheduledAction *model.ScheduledAction, window time.Duration) ([]*model.ScheduledAction, error)
+}
@@ -0,0 +1,21 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type EntrySnapshots interface {
+	Get(ctx context.Context, entryId string, snapshotId string) (*model.EntrySnapshot, error)
+
+	// List returns the snapshots of the entry, one for every time it was published
+	List(ctx context.Context, entryId string) NextableCollection[*model.EntrySnapshot, any]
+}
+
+type ContentTypeSnapshots interface {
+	Get(ctx context.Context, contentTypeId string, snapshotId string) (*model.ContentTypeSnapshot, error)
+
+	// List returns the snapshots of the content type, one for every time it was activated
+	List(ctx context.Context, contentTypeId string) NextableCollection[*model.ContentTypeSnapshot, any]
+}
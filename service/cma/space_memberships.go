@@ -0,0 +1,18 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type SpaceMemberships interface {
+	Get(ctx context.Context, membershipId string) (*model.SpaceMembership, error)
+
+	List(ctx context.Context) NextableCollection[*model.SpaceMembership, any]
+
+	// Upsert invites the user with the email of a new membership, or updates the roles of an existing one
+	Upsert(ctx context.Context, membership *model.SpaceMembership) error
+
+	Delete(ctx context.Context, membership *model.SpaceMembership) error
+}
@@ -0,0 +1,28 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type Spaces interface {
+	Get(ctx context.Context, spaceId string) (*model.Space, error)
+
+	// List returns all spaces the user has access to
+	List(ctx context.Context) NextableCollection[*model.Space, any]
+
+	// ListByOrganization returns the spaces of the organization the user has access to
+	ListByOrganization(ctx context.Context, organizationId string) ([]*model.Space, error)
+
+	// Upsert creates the space in its organization, see model.NewSpace, or renames it.
+	// A common.PlanLimitExceededError is returned when the plan allows no more spaces.
+	Upsert(ctx context.Context, space *model.Space) error
+
+	Delete(ctx context.Context, space *model.Space) error
+}
+
+type Organizations interface {
+	// List returns the organizations the user is a member of
+	List(ctx context.Context) NextableCollection[*model.Organization, any]
+}
@@ -0,0 +1,18 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type TeamSpaceMemberships interface {
+	Get(ctx context.Context, membershipId string) (*model.TeamSpaceMembership, error)
+
+	List(ctx context.Context) NextableCollection[*model.TeamSpaceMembership, any]
+
+	// Upsert gives the team of a new membership access to the space, or updates the roles of an existing one
+	Upsert(ctx context.Context, membership *model.TeamSpaceMembership) error
+
+	Delete(ctx context.Context, membership *model.TeamSpaceMembership) error
+}
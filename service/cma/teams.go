@@ -0,0 +1,30 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type Teams interface {
+	Get(ctx context.Context, teamId string) (*model.Team, error)
+
+	List(ctx context.Context) NextableCollection[*model.Team, any]
+
+	Upsert(ctx context.Context, team *model.Team) error
+
+	Delete(ctx context.Context, team *model.Team) error
+}
+
+type TeamMemberships interface {
+	// List returns the memberships of the team
+	List(ctx context.Context, teamId string) NextableCollection[*model.TeamMembership, any]
+
+	// ListAll returns the memberships of all teams of the organization
+	ListAll(ctx context.Context) NextableCollection[*model.TeamMembership, any]
+
+	// Create adds the member of the organization to the team
+	Create(ctx context.Context, teamId string, membership *model.TeamMembership) error
+
+	Delete(ctx context.Context, membership *model.TeamMembership) error
+}
@@ -0,0 +1,30 @@
+package cma
+
+import (
+	"context"
+	"io"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+// MaxUploadSize is the maximum size of a single upload accepted by the upload API
+const MaxUploadSize int64 = 1000 * 1024 * 1024
+
+type UploadOptions struct {
+	// Size of the upload in bytes. When unknown the body is sent with chunked transfer encoding.
+	Size int64
+	// Progress is called after every chunk read from the reader. Total is 0 when the size is unknown.
+	Progress func(written int64, total int64)
+}
+
+type Uploads interface {
+	Get(ctx context.Context, uploadId string) (*model.Upload, error)
+
+	// Create streams the reader to the upload API without buffering it in memory. The
+	// upload API accepts a file in a single request of at most MaxUploadSize bytes and
+	// has no multipart or resumable uploads, so large files are sent as one streamed
+	// request, with chunked transfer encoding when the size is unknown.
+	Create(ctx context.Context, reader io.Reader, options *UploadOptions) (*model.Upload, error)
+
+	Delete(ctx context.Context, upload *model.Upload) error
+}
@@ -0,0 +1,13 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type Users interface {
+	Get(ctx context.Context, userId string) (*model.User, error)
+
+	List(ctx context.Context) NextableCollection[*model.User, any]
+}
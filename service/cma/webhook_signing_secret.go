@@ -0,0 +1,22 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type WebhookSigningSecret interface {
+	// Get returns the redacted signing secret of the space
+	Get(ctx context.Context) (*model.WebhookSigningSecret, error)
+
+	// Create sets the signing secret of the space to the given value, which must be
+	// 64 alphanumeric characters. An empty value generates a random secret.
+	Create(ctx context.Context, value string) (*model.WebhookSigningSecret, error)
+
+	// Rotate replaces the signing secret with a new random secret, which is returned
+	Rotate(ctx context.Context) (*model.WebhookSigningSecret, error)
+
+	// Delete removes the signing secret, after which webhooks are no longer signed
+	Delete(ctx context.Context) error
+}
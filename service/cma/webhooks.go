@@ -0,0 +1,28 @@
+package cma
+
+import (
+	"context"
+
+	"github.com/labd/contentful-go/pkgs/model"
+)
+
+type Webhooks interface {
+	Get(ctx context.Context, webhookId string) (*model.Webhook, error)
+
+	List(ctx context.Context) NextableCollection[*model.Webhook, any]
+
+	Upsert(ctx context.Context, webhook *model.Webhook) error
+
+	Delete(ctx context.Context, webhook *model.Webhook) error
+}
+
+type WebhookCalls interface {
+	// Get returns the details of a single call, including request and response
+	Get(ctx context.Context, webhookId string, callId string) (*model.WebhookCall, error)
+
+	// List returns the most recent calls of the webhook, without request and response
+	List(ctx context.Context, webhookId string) NextableCollection[*model.WebhookCall, any]
+
+	// Health returns the number of healthy calls among the most recent calls of the webhook
+	Health(ctx context.Context, webhookId string) (*model.WebhookHealth, error)
+}
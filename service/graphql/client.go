@@ -0,0 +1,38 @@
+package graphql
+
+import (
+	"context"
+)
+
+type SpaceIdClientBuilder interface {
+	WithSpaceId(spaceId string) SpaceIdClient
+}
+
+type SpaceIdClient interface {
+	WithEnvironment(environment string) EnvironmentClient
+}
+
+type EnvironmentClient interface {
+	// Query posts the request and decodes the data of the response into result.
+	// When the response contains both data and errors, result is filled and the
+	// errors are returned.
+	Query(ctx context.Context, request Request, result any) (*Response, error)
+	// Preview returns a client which queries draft content using the given preview
+	// token. The preview variable is set to true on every request, so queries can
+	// declare $preview: Boolean and pass it on to collection and entry fields.
+	Preview(previewToken string) EnvironmentClient
+}
+
+// Request is a GraphQL query with its variables
+type Request struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables,omitempty"`
+	OperationName string         `json:"operationName,omitempty"`
+}
+
+// Response holds the metadata of a GraphQL response
+type Response struct {
+	RequestID string
+	// Cost is the complexity of the query as calculated by Contentful
+	Cost int
+}
@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// noinspection GoUnusedConst
+const (
+	CodeTooComplexQuery    = "TOO_COMPLEX_QUERY"
+	CodeAccessTokenInvalid = "ACCESS_TOKEN_INVALID"
+	CodeUnknownLocale      = "UNKNOWN_LOCALE"
+	CodeUnknownEnvironment = "UNKNOWN_ENVIRONMENT"
+	CodeUnresolvableLink   = "UNRESOLVABLE_LINK"
+	CodeQueryTooBig        = "QUERY_TOO_BIG"
+)
+
+// Location points to a position in the query
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// ContentfulExtension holds the Contentful specific details of an error
+type ContentfulExtension struct {
+	Code      string         `json:"code,omitempty"`
+	RequestID string         `json:"requestId,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+}
+
+// Error is a single entry of the errors of a GraphQL response
+type Error struct {
+	Message    string     `json:"message"`
+	Locations  []Location `json:"locations,omitempty"`
+	Path       []any      `json:"path,omitempty"`
+	Extensions struct {
+		Contentful *ContentfulExtension `json:"contentful,omitempty"`
+	} `json:"extensions,omitempty"`
+}
+
+// Code returns the Contentful error code, or an empty string when not known
+func (e Error) Code() string {
+	if e.Extensions.Contentful == nil {
+		return ""
+	}
+
+	return e.Extensions.Contentful.Code
+}
+
+func (e Error) Error() string {
+	if code := e.Code(); code != "" {
+		return fmt.Sprintf("%s: %s", code, e.Message)
+	}
+
+	return e.Message
+}
+
+// ResponseError is returned when a GraphQL response contains errors
+type ResponseError struct {
+	StatusCode int
+	Errors     []Error
+}
+
+func (e ResponseError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+
+	return "graphql: " + strings.Join(messages, "; ")
+}
+
+// HasCode reports whether any of the errors has the given Contentful error code
+func (e ResponseError) HasCode(code string) bool {
+	for _, err := range e.Errors {
+		if err.Code() == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// QueryTooComplexError is returned when the complexity of a query exceeds the
+// limit of the space
+type QueryTooComplexError struct {
+	ResponseError
+	Cost        int
+	MaximumCost int
+}
+
+func (e QueryTooComplexError) Error() string {
+	return fmt.Sprintf("graphql: query cost %d exceeds the maximum of %d", e.Cost, e.MaximumCost)
+}